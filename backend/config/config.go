@@ -1,20 +1,31 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"strconv"
 	"time"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
+	Server      ServerConfig
+	Database    DatabaseConfig
+	JWT         JWTConfig
+	Booking     BookingConfig
+	Appointment AppointmentConfig
+	Storage     StorageConfig
+	Mail        MailConfig
+	Login       LoginConfig
+	RateLimit   RateLimitConfig
+	Live        LiveConfig
 }
 
 type ServerConfig struct {
 	Port           string
 	Environment    string
 	AllowedOrigins []string
+	// Take the client IP from X-Forwarded-For / X-Real-IP, only safe behind a proxy that sets them
+	TrustProxyHeaders bool
 }
 
 type DatabaseConfig struct {
@@ -32,14 +43,83 @@ type JWTConfig struct {
 	RefreshTokenExpiry time.Duration
 }
 
+type BookingConfig struct {
+	// Bookings cancelled at least this long before they start are refundable
+	CancellationWindow time.Duration
+	// How often past bookings are swept into their final status
+	SweepInterval time.Duration
+}
+
+type AppointmentConfig struct {
+	// Patients must cancel or reschedule at least this long before an appointment starts
+	NoticePeriod time.Duration
+}
+
+type StorageConfig struct {
+	// Directory uploaded files are written to
+	UploadDir string
+	// URL prefix the upload directory is served from
+	UploadURL string
+}
+
+type MailConfig struct {
+	// How emails are delivered: "smtp" sends them through the SMTP server;
+	// "log" writes them to the log and "file" to Dir, for development only
+	Driver string
+	Dir    string
+	// SMTP server and the address emails are sent from
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	From         string
+	// Base URL of the app that verification and reset links point to
+	AppURL string
+	// How long emailed verification and password reset links stay valid
+	VerificationExpiry  time.Duration
+	PasswordResetExpiry time.Duration
+}
+
+type LoginConfig struct {
+	// Failed logins allowed per account and per IP address before a lockout
+	MaxAccountFailures int
+	MaxIPFailures      int
+	// Wait after a failed login, doubling with each further failure up to MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// How long a lockout lasts
+	LockoutDuration time.Duration
+}
+
+type RateLimitConfig struct {
+	Window time.Duration
+	// Requests per window and client IP on public auth routes and on posting
+	Auth  int
+	Posts int
+}
+
+type LiveConfig struct {
+	// Events kept per match so live followers can resume after reconnecting
+	HistorySize int
+}
+
 func Load() *Config {
+	env := getEnv("ENV", "development")
+
+	// Development logs emails unless told otherwise, everywhere else needs SMTP
+	mailDriver := "smtp"
+	if env == "development" {
+		mailDriver = "log"
+	}
+
 	return &Config{
 		Server: ServerConfig{
 			Port:        getEnv("PORT", "8080"),
-			Environment: getEnv("ENV", "development"),
+			Environment: env,
 			AllowedOrigins: []string{
 				getEnv("ALLOWED_ORIGINS", "http://localhost:3000"),
 			},
+			TrustProxyHeaders: getEnv("TRUST_PROXY_HEADERS", "false") == "true",
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -54,12 +134,86 @@ func Load() *Config {
 			AccessTokenExpiry:  15 * time.Minute,
 			RefreshTokenExpiry: 7 * 24 * time.Hour,
 		},
+		Booking: BookingConfig{
+			CancellationWindow: getEnvDuration("BOOKING_CANCELLATION_WINDOW", 24*time.Hour),
+			SweepInterval:      getEnvDuration("BOOKING_SWEEP_INTERVAL", 15*time.Minute),
+		},
+		Appointment: AppointmentConfig{
+			NoticePeriod: getEnvDuration("APPOINTMENT_NOTICE_PERIOD", 12*time.Hour),
+		},
+		Storage: StorageConfig{
+			UploadDir: getEnv("UPLOAD_DIR", "./uploads"),
+			UploadURL: getEnv("UPLOAD_URL", "/uploads"),
+		},
+		Mail: MailConfig{
+			Driver:              getEnv("MAIL_DRIVER", mailDriver),
+			Dir:                 getEnv("MAIL_DIR", "./mail"),
+			SMTPHost:            getEnv("SMTP_HOST", ""),
+			SMTPPort:            getEnv("SMTP_PORT", "587"),
+			SMTPUsername:        getEnv("SMTP_USERNAME", ""),
+			SMTPPassword:        getEnv("SMTP_PASSWORD", ""),
+			From:                getEnv("MAIL_FROM", ""),
+			AppURL:              getEnv("APP_URL", "http://localhost:3000"),
+			VerificationExpiry:  getEnvDuration("EMAIL_VERIFICATION_EXPIRY", 48*time.Hour),
+			PasswordResetExpiry: getEnvDuration("PASSWORD_RESET_EXPIRY", time.Hour),
+		},
+		Login: LoginConfig{
+			MaxAccountFailures: getEnvInt("LOGIN_MAX_ACCOUNT_FAILURES", 5),
+			MaxIPFailures:      getEnvInt("LOGIN_MAX_IP_FAILURES", 20),
+			Backoff:            getEnvDuration("LOGIN_BACKOFF", time.Second),
+			MaxBackoff:         getEnvDuration("LOGIN_MAX_BACKOFF", 30*time.Second),
+			LockoutDuration:    getEnvDuration("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+		},
+		RateLimit: RateLimitConfig{
+			Window: getEnvDuration("RATE_LIMIT_WINDOW", time.Minute),
+			Auth:   getEnvInt("RATE_LIMIT_AUTH", 10),
+			Posts:  getEnvInt("RATE_LIMIT_POSTS", 20),
+		},
+		Live: LiveConfig{
+			HistorySize: getEnvInt("LIVE_HISTORY_SIZE", 200),
+		},
 	}
 }
 
+// Validate reports settings the server must not start with
+func (c *Config) Validate() error {
+	switch c.Mail.Driver {
+	case "smtp":
+		if c.Mail.SMTPHost == "" || c.Mail.From == "" {
+			return fmt.Errorf("MAIL_DRIVER smtp needs SMTP_HOST and MAIL_FROM")
+		}
+	case "log", "file":
+		// These drivers expose verification and password reset links
+		if c.Server.Environment != "development" {
+			return fmt.Errorf("MAIL_DRIVER %s is only allowed when ENV is development", c.Mail.Driver)
+		}
+	default:
+		return fmt.Errorf("unknown MAIL_DRIVER: %s", c.Mail.Driver)
+	}
+	return nil
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
 	return defaultValue
 }
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.Atoi(value); err == nil {
+			return n
+		}
+	}
+	return defaultValue
+}
@@ -15,6 +15,13 @@ RDS_ENDPOINT="cricket-app-db.c5qs0k4sk65n.ap-south-1.rds.amazonaws.com"
 RDS_PASSWORD="Crick456"
 JWT_SECRET=$(openssl rand -base64 32)
 
+# Mail relay for verification and password reset emails, the server will not start without it
+SMTP_HOST="${SMTP_HOST:?Set SMTP_HOST to the SMTP relay host}"
+SMTP_PORT="${SMTP_PORT:-587}"
+SMTP_USERNAME="${SMTP_USERNAME:-}"
+SMTP_PASSWORD="${SMTP_PASSWORD:-}"
+MAIL_FROM="${MAIL_FROM:?Set MAIL_FROM to the sender address}"
+
 echo "📦 Step 1: Installing system dependencies..."
 sudo apt update
 sudo apt install -y docker.io docker-compose postgresql-client
@@ -49,6 +56,14 @@ REFRESH_TOKEN_EXPIRY=168h
 
 # CORS
 ALLOWED_ORIGINS=*
+
+# Mail Configuration
+MAIL_DRIVER=smtp
+SMTP_HOST=${SMTP_HOST}
+SMTP_PORT=${SMTP_PORT}
+SMTP_USERNAME=${SMTP_USERNAME}
+SMTP_PASSWORD=${SMTP_PASSWORD}
+MAIL_FROM=${MAIL_FROM}
 EOF
 
 echo "✅ .env file created"
@@ -3,13 +3,18 @@ package http
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/cricketapp/backend/config"
 	"github.com/cricketapp/backend/internal/auth/domain"
+	"github.com/cricketapp/backend/internal/auth/mail"
 	"github.com/cricketapp/backend/internal/auth/repository/postgres"
 	"github.com/cricketapp/backend/internal/auth/service"
 	"github.com/cricketapp/backend/internal/auth/util"
+	"github.com/cricketapp/backend/internal/http/middleware"
 )
 
 type AuthHandler struct {
@@ -18,8 +23,34 @@ type AuthHandler struct {
 
 func NewAuthHandler(db *sql.DB, cfg *config.Config) *AuthHandler {
 	userRepo := postgres.NewUserRepository(db)
+	tokenRepo := postgres.NewTokenRepository(db)
+	actionTokenRepo := postgres.NewActionTokenRepository(db)
+	attemptRepo := postgres.NewLoginAttemptRepository(db)
+	auditRepo := postgres.NewAuditRepository(db)
 	jwtUtil := util.NewJWTUtil(cfg.JWT.Secret, cfg.JWT.AccessTokenExpiry)
-	authService := service.NewAuthService(userRepo, jwtUtil)
+
+	// config.Validate only allows the log and file drivers in development
+	var mailer domain.Mailer
+	switch cfg.Mail.Driver {
+	case "log":
+		mailer = mail.NewLogMailer()
+	case "file":
+		mailer = mail.NewFileMailer(cfg.Mail.Dir)
+	default:
+		mailer = mail.NewSMTPMailer(cfg.Mail.SMTPHost, cfg.Mail.SMTPPort, cfg.Mail.SMTPUsername, cfg.Mail.SMTPPassword, cfg.Mail.From)
+	}
+
+	authService := service.NewAuthService(userRepo, tokenRepo, actionTokenRepo, attemptRepo, auditRepo, mailer, jwtUtil, service.Settings{
+		RefreshExpiry:       cfg.JWT.RefreshTokenExpiry,
+		VerificationExpiry:  cfg.Mail.VerificationExpiry,
+		PasswordResetExpiry: cfg.Mail.PasswordResetExpiry,
+		AppURL:              cfg.Mail.AppURL,
+		MaxAccountFailures:  cfg.Login.MaxAccountFailures,
+		MaxIPFailures:       cfg.Login.MaxIPFailures,
+		LoginBackoff:        cfg.Login.Backoff,
+		MaxLoginBackoff:     cfg.Login.MaxBackoff,
+		LockoutDuration:     cfg.Login.LockoutDuration,
+	})
 
 	return &AuthHandler{
 		authService: authService,
@@ -53,8 +84,16 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	req.IPAddress = middleware.ClientIP(r)
+
 	response, err := h.authService.Login(r.Context(), &req)
 	if err != nil {
+		var throttled *domain.ThrottledError
+		if errors.As(err, &throttled) {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+			respondError(w, http.StatusTooManyRequests, err.Error())
+			return
+		}
 		respondError(w, http.StatusUnauthorized, err.Error())
 		return
 	}
@@ -65,6 +104,140 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// Refresh handles POST /api/v1/auth/refresh
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	var req domain.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	response, err := h.authService.Refresh(r.Context(), req.RefreshToken)
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   response,
+	})
+}
+
+// Logout handles POST /api/v1/auth/logout, revoking the session the refresh token belongs to
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var req domain.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.authService.Logout(r.Context(), req.RefreshToken); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"message": "Logged out successfully",
+	})
+}
+
+// LogoutAll handles POST /api/v1/auth/logout-all, revoking every session of the caller
+func (h *AuthHandler) LogoutAll(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	if err := h.authService.LogoutAll(r.Context(), userID); err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"message": "Logged out of all devices",
+	})
+}
+
+// ResendVerification handles POST /api/v1/auth/verify-email/resend
+func (h *AuthHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	if err := h.authService.SendVerificationEmail(r.Context(), userID); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"message": "Verification email sent",
+	})
+}
+
+// VerifyEmail handles POST /api/v1/auth/verify-email
+func (h *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req domain.VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.authService.VerifyEmail(r.Context(), req.Token); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"message": "Email verified successfully",
+	})
+}
+
+// ForgotPassword handles POST /api/v1/auth/forgot-password
+func (h *AuthHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req domain.ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.authService.RequestPasswordReset(r.Context(), req.Email); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"message": "If an account exists for this email, a password reset link has been sent",
+	})
+}
+
+// ResetPassword handles POST /api/v1/auth/reset-password
+func (h *AuthHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req domain.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.authService.ResetPassword(r.Context(), &req); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"message": "Password reset successfully",
+	})
+}
+
 func respondJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
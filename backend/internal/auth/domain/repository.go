@@ -1,6 +1,9 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // UserRepository defines the interface for user data access
 type UserRepository interface {
@@ -8,4 +11,36 @@ type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	GetByID(ctx context.Context, id string) (*User, error)
 	Update(ctx context.Context, user *User) error
+	MarkVerified(ctx context.Context, id string) error
+	UpdatePassword(ctx context.Context, id, passwordHash string) error
+}
+
+// TokenRepository defines the interface for refresh token storage
+type TokenRepository interface {
+	Create(ctx context.Context, token *RefreshToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	Rotate(ctx context.Context, old, replacement *RefreshToken) error
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeAllForUser(ctx context.Context, userID string) error
+}
+
+// ActionTokenRepository defines the interface for emailed single-use tokens
+type ActionTokenRepository interface {
+	Create(ctx context.Context, token *ActionToken) error
+	Consume(ctx context.Context, purpose, tokenHash string) (*ActionToken, error)
+}
+
+// LoginAttemptRepository defines the interface for failed login tracking
+type LoginAttemptRepository interface {
+	Get(ctx context.Context, scope, key string) (*LoginAttempts, error)
+	// RecordFailure counts a failure, starting over when the previous one was
+	// before resetBefore or the last lock has run out
+	RecordFailure(ctx context.Context, scope, key string, resetBefore time.Time) (*LoginAttempts, error)
+	Lock(ctx context.Context, scope, key string, until time.Time) error
+	Clear(ctx context.Context, scope, key string) error
+}
+
+// AuditRepository defines the interface for the security audit log
+type AuditRepository interface {
+	Record(ctx context.Context, entry *AuditEntry) error
 }
@@ -0,0 +1,39 @@
+package domain
+
+// User roles. Every role except admin can be chosen at registration; admins
+// are appointed directly in the database.
+const (
+	RolePlayer      = "player"
+	RoleTeamManager = "team_manager"
+	RoleGroundOwner = "ground_owner"
+	RolePhysio      = "physio"
+	RoleOrganizer   = "organizer"
+	RoleAdmin       = "admin"
+)
+
+// RegistrableRoles are the roles a user can pick when signing up
+var RegistrableRoles = map[string]bool{
+	RolePlayer:      true,
+	RoleTeamManager: true,
+	RoleGroundOwner: true,
+	RolePhysio:      true,
+	RoleOrganizer:   true,
+}
+
+// legacyRoles maps roles sent by older app versions onto the current role set.
+// Umpires, commentators, streamers and coaches are profiles, not permissions.
+var legacyRoles = map[string]string{
+	"organiser":      RoleOrganizer,
+	"umpire":         RolePlayer,
+	"commentator":    RolePlayer,
+	"streamer":       RolePlayer,
+	"personal_coach": RolePlayer,
+}
+
+// NormalizeRole returns the current name of a role
+func NormalizeRole(role string) string {
+	if current, ok := legacyRoles[role]; ok {
+		return current
+	}
+	return role
+}
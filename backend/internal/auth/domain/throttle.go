@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Scopes failed login attempts are counted in
+const (
+	ScopeIP      = "ip"
+	ScopeAccount = "account"
+)
+
+// Audit events
+const (
+	AuditAccountLocked = "account_locked"
+	AuditIPLocked      = "ip_locked"
+)
+
+// LoginAttempts tracks recent failed logins from one IP address or for one account
+type LoginAttempts struct {
+	Scope         string
+	Key           string
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+}
+
+// AuditEntry records a security relevant event
+type AuditEntry struct {
+	ID        string
+	Event     string
+	UserID    string
+	Email     string
+	IPAddress string
+	Details   string
+	CreatedAt time.Time
+}
+
+// ThrottledError is returned when a login is refused because of earlier
+// failures, before the credentials are checked
+type ThrottledError struct {
+	RetryAfter time.Duration
+	Locked     bool
+}
+
+func (e *ThrottledError) Error() string {
+	wait := e.RetryAfter.Round(time.Second)
+	if wait < time.Second {
+		wait = time.Second
+	}
+	if e.Locked {
+		return fmt.Sprintf("too many failed login attempts, try again in %s", wait)
+	}
+	return fmt.Sprintf("please wait %s before trying again", wait)
+}
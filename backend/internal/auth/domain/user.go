@@ -27,8 +27,9 @@ type RegisterRequest struct {
 
 // LoginRequest represents login credentials
 type LoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email     string `json:"email"`
+	Password  string `json:"password"`
+	IPAddress string `json:"-"` // Set from the request, used for throttling
 }
 
 // AuthResponse represents authentication response with tokens
@@ -38,3 +39,53 @@ type AuthResponse struct {
 	RefreshToken string `json:"refresh_token"`
 	ExpiresIn    int    `json:"expires_in"` // seconds
 }
+
+// RefreshToken is a stored refresh token. Only the hash of the token is kept.
+type RefreshToken struct {
+	ID         string
+	UserID     string
+	TokenHash  string
+	FamilyID   string // Shared by every token rotated from the same login
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+	ReplacedBy *string
+	CreatedAt  time.Time
+}
+
+// RefreshRequest carries the refresh token to exchange or revoke
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Purposes of an emailed action token
+const (
+	TokenEmailVerification = "email_verification"
+	TokenPasswordReset     = "password_reset"
+)
+
+// ActionToken is a single-use token emailed to a user. Only the hash is kept.
+type ActionToken struct {
+	ID        string
+	UserID    string
+	Purpose   string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// VerifyEmailRequest carries the token from a verification email
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// ForgotPasswordRequest asks for a password reset email
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+// ResetPasswordRequest sets a new password using the token from a reset email
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
@@ -0,0 +1,21 @@
+package mail
+
+import (
+	"context"
+	"log"
+
+	"github.com/cricketapp/backend/internal/auth/domain"
+)
+
+type logMailer struct{}
+
+// NewLogMailer writes emails to the application log instead of sending them,
+// for local development
+func NewLogMailer() domain.Mailer {
+	return &logMailer{}
+}
+
+func (m *logMailer) Send(ctx context.Context, msg *domain.Message) error {
+	log.Printf("Email to %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/auth/domain"
+)
+
+type actionTokenRepository struct {
+	db *sql.DB
+}
+
+// NewActionTokenRepository creates a new PostgreSQL repository for emailed tokens
+func NewActionTokenRepository(db *sql.DB) domain.ActionTokenRepository {
+	return &actionTokenRepository{db: db}
+}
+
+// Create stores a token, invalidating any earlier unused token the user has
+// for the same purpose so only the latest email works
+func (r *actionTokenRepository) Create(ctx context.Context, token *domain.ActionToken) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM user_action_tokens
+		WHERE user_id = $1 AND (purpose = $2 OR expires_at < CURRENT_TIMESTAMP)
+	`, token.UserID, token.Purpose)
+	if err != nil {
+		return fmt.Errorf("failed to delete old tokens: %w", err)
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO user_action_tokens (user_id, purpose, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`, token.UserID, token.Purpose, token.TokenHash, token.ExpiresAt).Scan(&token.ID, &token.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store token: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Consume marks an unused, unexpired token as used and returns it. A token
+// can only be consumed once.
+func (r *actionTokenRepository) Consume(ctx context.Context, purpose, tokenHash string) (*domain.ActionToken, error) {
+	query := `
+		UPDATE user_action_tokens
+		SET used_at = CURRENT_TIMESTAMP
+		WHERE purpose = $1 AND token_hash = $2
+		  AND used_at IS NULL AND expires_at > CURRENT_TIMESTAMP
+		RETURNING id, user_id, purpose, token_hash, expires_at, used_at, created_at
+	`
+
+	token := &domain.ActionToken{}
+	var usedAt sql.NullTime
+
+	err := r.db.QueryRowContext(ctx, query, purpose, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Purpose,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&usedAt,
+		&token.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("token is invalid or has expired")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to use token: %w", err)
+	}
+
+	if usedAt.Valid {
+		token.UsedAt = &usedAt.Time
+	}
+
+	return token, nil
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cricketapp/backend/internal/auth/domain"
+)
+
+type loginAttemptRepository struct {
+	db *sql.DB
+}
+
+// NewLoginAttemptRepository creates a new PostgreSQL failed login repository
+func NewLoginAttemptRepository(db *sql.DB) domain.LoginAttemptRepository {
+	return &loginAttemptRepository{db: db}
+}
+
+func (r *loginAttemptRepository) Get(ctx context.Context, scope, key string) (*domain.LoginAttempts, error) {
+	query := `
+		SELECT scope, key, failures, last_failure_at, locked_until
+		FROM login_attempts
+		WHERE scope = $1 AND key = $2
+	`
+
+	attempts, err := scanLoginAttempts(r.db.QueryRowContext(ctx, query, scope, key))
+	if err == sql.ErrNoRows {
+		return &domain.LoginAttempts{Scope: scope, Key: key}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get login attempts: %w", err)
+	}
+
+	return attempts, nil
+}
+
+func (r *loginAttemptRepository) RecordFailure(ctx context.Context, scope, key string, resetBefore time.Time) (*domain.LoginAttempts, error) {
+	query := `
+		INSERT INTO login_attempts (scope, key, failures, last_failure_at)
+		VALUES ($1, $2, 1, $3)
+		ON CONFLICT (scope, key) DO UPDATE SET
+			failures = CASE
+				WHEN login_attempts.last_failure_at < $4 OR login_attempts.locked_until < $3 THEN 1
+				ELSE login_attempts.failures + 1
+			END,
+			locked_until = CASE
+				WHEN login_attempts.locked_until < $3 THEN NULL
+				ELSE login_attempts.locked_until
+			END,
+			last_failure_at = $3
+		RETURNING scope, key, failures, last_failure_at, locked_until
+	`
+
+	attempts, err := scanLoginAttempts(r.db.QueryRowContext(ctx, query, scope, key, time.Now(), resetBefore))
+	if err != nil {
+		return nil, fmt.Errorf("failed to record login failure: %w", err)
+	}
+
+	return attempts, nil
+}
+
+func (r *loginAttemptRepository) Lock(ctx context.Context, scope, key string, until time.Time) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE login_attempts SET locked_until = $1 WHERE scope = $2 AND key = $3", until, scope, key)
+	if err != nil {
+		return fmt.Errorf("failed to lock login: %w", err)
+	}
+
+	return nil
+}
+
+func (r *loginAttemptRepository) Clear(ctx context.Context, scope, key string) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM login_attempts WHERE scope = $1 AND key = $2", scope, key)
+	if err != nil {
+		return fmt.Errorf("failed to clear login attempts: %w", err)
+	}
+
+	return nil
+}
+
+func scanLoginAttempts(row *sql.Row) (*domain.LoginAttempts, error) {
+	attempts := &domain.LoginAttempts{}
+	var lockedUntil sql.NullTime
+
+	err := row.Scan(&attempts.Scope, &attempts.Key, &attempts.Failures, &attempts.LastFailureAt, &lockedUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	if lockedUntil.Valid {
+		attempts.LockedUntil = &lockedUntil.Time
+	}
+
+	return attempts, nil
+}
+
+type auditRepository struct {
+	db *sql.DB
+}
+
+// NewAuditRepository creates a new PostgreSQL audit log repository
+func NewAuditRepository(db *sql.DB) domain.AuditRepository {
+	return &auditRepository{db: db}
+}
+
+func (r *auditRepository) Record(ctx context.Context, entry *domain.AuditEntry) error {
+	query := `
+		INSERT INTO auth_audit_log (event, user_id, email, ip_address, details)
+		VALUES ($1, NULLIF($2, '')::uuid, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''))
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, entry.Event, entry.UserID, entry.Email, entry.IPAddress, entry.Details).
+		Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+
+	return nil
+}
@@ -154,3 +154,23 @@ func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
 
 	return nil
 }
+
+func (r *userRepository) MarkVerified(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE users SET is_verified = true, updated_at = CURRENT_TIMESTAMP WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to verify user: %w", err)
+	}
+
+	return nil
+}
+
+func (r *userRepository) UpdatePassword(ctx context.Context, id, passwordHash string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE users SET password_hash = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2", passwordHash, id)
+	if err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/cricketapp/backend/internal/auth/domain"
+	"github.com/cricketapp/backend/internal/auth/util"
+)
+
+func (s *authService) SendVerificationEmail(ctx context.Context, userID string) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.IsVerified {
+		return fmt.Errorf("email is already verified")
+	}
+
+	return s.sendVerification(ctx, user)
+}
+
+func (s *authService) VerifyEmail(ctx context.Context, token string) error {
+	if token == "" {
+		return fmt.Errorf("token is required")
+	}
+
+	stored, err := s.actionTokenRepo.Consume(ctx, domain.TokenEmailVerification, util.HashToken(token))
+	if err != nil {
+		return err
+	}
+
+	return s.userRepo.MarkVerified(ctx, stored.UserID)
+}
+
+func (s *authService) RequestPasswordReset(ctx context.Context, email string) error {
+	if email == "" {
+		return fmt.Errorf("email is required")
+	}
+
+	// Answer the same way for unknown addresses so accounts cannot be discovered
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		return nil
+	}
+
+	// Failures past this point are only logged, an error would tell the caller the account exists
+	link, err := s.createActionLink(ctx, user.ID, domain.TokenPasswordReset, s.settings.PasswordResetExpiry, "/reset-password")
+	if err != nil {
+		log.Printf("Failed to create password reset link for %s: %v", user.Email, err)
+		return nil
+	}
+
+	msg := &domain.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nUse the link below to choose a new password. It expires in %s.\n\n%s\n\n"+
+			"If you did not ask to reset your password you can ignore this email.",
+			user.FullName, s.settings.PasswordResetExpiry, link),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		log.Printf("Failed to send password reset email to %s: %v", user.Email, err)
+	}
+
+	return nil
+}
+
+func (s *authService) ResetPassword(ctx context.Context, req *domain.ResetPasswordRequest) error {
+	if req.Token == "" {
+		return fmt.Errorf("token is required")
+	}
+	if err := validatePassword(req.NewPassword); err != nil {
+		return err
+	}
+
+	stored, err := s.actionTokenRepo.Consume(ctx, domain.TokenPasswordReset, util.HashToken(req.Token))
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := util.HashPassword(req.NewPassword)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, stored.UserID, hashedPassword); err != nil {
+		return err
+	}
+
+	// Whoever knew the old password should not stay logged in
+	return s.tokenRepo.RevokeAllForUser(ctx, stored.UserID)
+}
+
+// sendVerification emails user a link to verify their address
+func (s *authService) sendVerification(ctx context.Context, user *domain.User) error {
+	link, err := s.createActionLink(ctx, user.ID, domain.TokenEmailVerification, s.settings.VerificationExpiry, "/verify-email")
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, &domain.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nConfirm your email address by opening the link below. It expires in %s.\n\n%s",
+			user.FullName, s.settings.VerificationExpiry, link),
+	})
+}
+
+// createActionLink stores a new single-use token and returns the app link carrying it
+func (s *authService) createActionLink(ctx context.Context, userID, purpose string, expiry time.Duration, path string) (string, error) {
+	token, hash, err := util.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = s.actionTokenRepo.Create(ctx, &domain.ActionToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: hash,
+		ExpiresAt: time.Now().Add(expiry),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return s.settings.AppURL + path + "?token=" + url.QueryEscape(token), nil
+}
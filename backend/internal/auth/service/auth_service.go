@@ -3,22 +3,56 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
 	"github.com/cricketapp/backend/internal/auth/domain"
 	"github.com/cricketapp/backend/internal/auth/util"
 )
 
 type authService struct {
-	userRepo domain.UserRepository
-	jwtUtil  *util.JWTUtil
+	userRepo        domain.UserRepository
+	tokenRepo       domain.TokenRepository
+	actionTokenRepo domain.ActionTokenRepository
+	attemptRepo     domain.LoginAttemptRepository
+	auditRepo       domain.AuditRepository
+	mailer          domain.Mailer
+	jwtUtil         *util.JWTUtil
+	settings        Settings
+}
+
+// Settings holds token lifetimes and where emailed links point to
+type Settings struct {
+	// Refresh tokens are valid this long and rotate each time they are used
+	RefreshExpiry       time.Duration
+	VerificationExpiry  time.Duration
+	PasswordResetExpiry time.Duration
+	// Base URL of the app handling the links in verification and reset emails
+	AppURL string
+	// Failed logins allowed per account and per IP address before locking out
+	MaxAccountFailures int
+	MaxIPFailures      int
+	// Wait after the first failed login, doubling with each further failure up to MaxLoginBackoff
+	LoginBackoff    time.Duration
+	MaxLoginBackoff time.Duration
+	// How long a lockout lasts. Failures older than this are forgotten.
+	LockoutDuration time.Duration
 }
 
 // NewAuthService creates a new authentication service
-func NewAuthService(userRepo domain.UserRepository, jwtUtil *util.JWTUtil) domain.AuthService {
+func NewAuthService(userRepo domain.UserRepository, tokenRepo domain.TokenRepository, actionTokenRepo domain.ActionTokenRepository,
+	attemptRepo domain.LoginAttemptRepository, auditRepo domain.AuditRepository, mailer domain.Mailer, jwtUtil *util.JWTUtil, settings Settings) domain.AuthService {
+	settings.AppURL = strings.TrimSuffix(settings.AppURL, "/")
 	return &authService{
-		userRepo: userRepo,
-		jwtUtil:  jwtUtil,
+		userRepo:        userRepo,
+		tokenRepo:       tokenRepo,
+		actionTokenRepo: actionTokenRepo,
+		attemptRepo:     attemptRepo,
+		auditRepo:       auditRepo,
+		mailer:          mailer,
+		jwtUtil:         jwtUtil,
+		settings:        settings,
 	}
 }
 
@@ -54,26 +88,14 @@ func (s *authService) Register(ctx context.Context, req *domain.RegisterRequest)
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	// Generate tokens
-	accessToken, err := s.jwtUtil.GenerateToken(user.ID, user.Email, user.Role)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate access token: %w", err)
+	// The account is usable straight away, so a failed email only means the
+	// user has to ask for another one
+	if err := s.sendVerification(ctx, user); err != nil {
+		log.Printf("Failed to send verification email to %s: %v", user.Email, err)
 	}
 
-	refreshToken, err := s.jwtUtil.GenerateToken(user.ID, user.Email, user.Role)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
-	}
-
-	// Remove password hash from response
-	user.PasswordHash = ""
-
-	return &domain.AuthResponse{
-		User:         user,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresIn:    900, // 15 minutes
-	}, nil
+	// Each login starts a new refresh token family
+	return s.issueTokens(ctx, user, nil)
 }
 
 func (s *authService) Login(ctx context.Context, req *domain.LoginRequest) (*domain.AuthResponse, error) {
@@ -82,54 +104,146 @@ func (s *authService) Login(ctx context.Context, req *domain.LoginRequest) (*dom
 		return nil, fmt.Errorf("email and password are required")
 	}
 
+	// Refuse early while the account or IP address is backing off or locked
+	attempts := s.loginAttemptKeys(req)
+	if err := s.checkThrottle(ctx, attempts); err != nil {
+		return nil, err
+	}
+
 	// Get user by email
 	user, err := s.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil {
+		s.recordLoginFailure(ctx, attempts, req, nil)
 		return nil, fmt.Errorf("invalid email or password")
 	}
 
 	// Check password
 	if err := util.CheckPassword(req.Password, user.PasswordHash); err != nil {
+		s.recordLoginFailure(ctx, attempts, req, user)
 		return nil, fmt.Errorf("invalid email or password")
 	}
 
-	// Generate tokens
-	accessToken, err := s.jwtUtil.GenerateToken(user.ID, user.Email, user.Role)
+	// The IP address keeps its count, so logging into one account does not
+	// reset guesses made against others
+	if err := s.attemptRepo.Clear(ctx, domain.ScopeAccount, accountKey(req.Email)); err != nil {
+		log.Printf("Failed to clear login attempts for %s: %v", req.Email, err)
+	}
+
+	// Each login starts a new refresh token family
+	return s.issueTokens(ctx, user, nil)
+}
+
+func (s *authService) ValidateToken(ctx context.Context, token string) (*domain.User, error) {
+	claims, err := s.jwtUtil.ValidateToken(token)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate access token: %w", err)
+		return nil, fmt.Errorf("invalid token: %w", err)
 	}
 
-	refreshToken, err := s.jwtUtil.GenerateToken(user.ID, user.Email, user.Role)
+	user, err := s.userRepo.GetByID(ctx, claims.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
 	// Remove password hash from response
 	user.PasswordHash = ""
 
-	return &domain.AuthResponse{
-		User:         user,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresIn:    900, // 15 minutes
-	}, nil
+	return user, nil
 }
 
-func (s *authService) ValidateToken(ctx context.Context, token string) (*domain.User, error) {
-	claims, err := s.jwtUtil.ValidateToken(token)
+func (s *authService) Refresh(ctx context.Context, refreshToken string) (*domain.AuthResponse, error) {
+	if refreshToken == "" {
+		return nil, fmt.Errorf("refresh token is required")
+	}
+
+	stored, err := s.tokenRepo.GetByHash(ctx, util.HashToken(refreshToken))
 	if err != nil {
-		return nil, fmt.Errorf("invalid token: %w", err)
+		return nil, fmt.Errorf("invalid refresh token")
 	}
 
-	user, err := s.userRepo.GetByID(ctx, claims.UserID)
+	// A rotated token being presented again means it has leaked, so every
+	// token issued from the same login is revoked
+	if stored.RevokedAt != nil {
+		if err := s.tokenRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("refresh token has already been used, please log in again")
+	}
+
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, fmt.Errorf("refresh token has expired, please log in again")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, stored.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("user not found: %w", err)
+		return nil, fmt.Errorf("invalid refresh token")
+	}
+
+	return s.issueTokens(ctx, user, stored)
+}
+
+func (s *authService) Logout(ctx context.Context, refreshToken string) error {
+	if refreshToken == "" {
+		return fmt.Errorf("refresh token is required")
+	}
+
+	stored, err := s.tokenRepo.GetByHash(ctx, util.HashToken(refreshToken))
+	if err != nil {
+		// Nothing to revoke, the session is already gone
+		return nil
+	}
+
+	return s.tokenRepo.RevokeFamily(ctx, stored.FamilyID)
+}
+
+func (s *authService) LogoutAll(ctx context.Context, userID string) error {
+	if userID == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	return s.tokenRepo.RevokeAllForUser(ctx, userID)
+}
+
+// issueTokens creates an access token and a refresh token for user. When
+// rotating is set the new refresh token replaces it in the same family.
+func (s *authService) issueTokens(ctx context.Context, user *domain.User, rotating *domain.RefreshToken) (*domain.AuthResponse, error) {
+	accessToken, err := s.jwtUtil.GenerateToken(user.ID, user.Email, user.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate access token: %w", err)
+	}
+
+	refreshToken, hash, err := util.GenerateOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	stored := &domain.RefreshToken{
+		UserID:    user.ID,
+		TokenHash: hash,
+		ExpiresAt: time.Now().Add(s.settings.RefreshExpiry),
+	}
+
+	if rotating != nil {
+		stored.FamilyID = rotating.FamilyID
+		if err := s.tokenRepo.Rotate(ctx, rotating, stored); err != nil {
+			// Lost a race with another refresh of the same token
+			if revokeErr := s.tokenRepo.RevokeFamily(ctx, rotating.FamilyID); revokeErr != nil {
+				return nil, revokeErr
+			}
+			return nil, fmt.Errorf("refresh token has already been used, please log in again")
+		}
+	} else if err := s.tokenRepo.Create(ctx, stored); err != nil {
+		return nil, err
 	}
 
 	// Remove password hash from response
 	user.PasswordHash = ""
 
-	return user, nil
+	return &domain.AuthResponse{
+		User:         user,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int(s.jwtUtil.Expiry().Seconds()),
+	}, nil
 }
 
 func (s *authService) validateRegisterRequest(req *domain.RegisterRequest) error {
@@ -142,27 +256,26 @@ func (s *authService) validateRegisterRequest(req *domain.RegisterRequest) error
 	if req.Password == "" {
 		return fmt.Errorf("password is required")
 	}
-	if len(req.Password) < 6 {
-		return fmt.Errorf("password must be at least 6 characters")
+	if err := validatePassword(req.Password); err != nil {
+		return err
 	}
 	if req.FullName == "" {
 		return fmt.Errorf("full name is required")
 	}
 	if req.Role == "" {
-		req.Role = "player" // Default role
+		req.Role = domain.RolePlayer // Default role
 	}
 	// Validate role
-	validRoles := map[string]bool{
-		"player":         true,
-		"umpire":         true,
-		"commentator":    true,
-		"streamer":       true,
-		"organiser":      true,
-		"personal_coach": true,
-		"physio":         true,
-	}
-	if !validRoles[req.Role] {
+	req.Role = domain.NormalizeRole(req.Role)
+	if !domain.RegistrableRoles[req.Role] {
 		return fmt.Errorf("invalid role: %s", req.Role)
 	}
 	return nil
 }
+
+func validatePassword(password string) error {
+	if len(password) < 6 {
+		return fmt.Errorf("password must be at least 6 characters")
+	}
+	return nil
+}
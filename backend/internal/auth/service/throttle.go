@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/cricketapp/backend/internal/auth/domain"
+)
+
+// attemptKey identifies a failed login counter
+type attemptKey struct {
+	scope string
+	key   string
+}
+
+func accountKey(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// loginAttemptKeys returns the counters a login attempt is checked against
+func (s *authService) loginAttemptKeys(req *domain.LoginRequest) []attemptKey {
+	keys := []attemptKey{{scope: domain.ScopeAccount, key: accountKey(req.Email)}}
+	if req.IPAddress != "" {
+		keys = append(keys, attemptKey{scope: domain.ScopeIP, key: req.IPAddress})
+	}
+	return keys
+}
+
+// checkThrottle returns a ThrottledError if any counter is locked or still
+// within the backoff after its last failure
+func (s *authService) checkThrottle(ctx context.Context, keys []attemptKey) error {
+	now := time.Now()
+
+	for _, k := range keys {
+		attempts, err := s.attemptRepo.Get(ctx, k.scope, k.key)
+		if err != nil {
+			return err
+		}
+
+		if attempts.LockedUntil != nil && now.Before(*attempts.LockedUntil) {
+			return &domain.ThrottledError{RetryAfter: attempts.LockedUntil.Sub(now), Locked: true}
+		}
+
+		if attempts.Failures == 0 || now.Sub(attempts.LastFailureAt) > s.settings.LockoutDuration {
+			continue
+		}
+
+		retryAt := attempts.LastFailureAt.Add(s.backoff(attempts.Failures))
+		if now.Before(retryAt) {
+			return &domain.ThrottledError{RetryAfter: retryAt.Sub(now)}
+		}
+	}
+
+	return nil
+}
+
+// backoff is the wait after the given number of consecutive failures
+func (s *authService) backoff(failures int) time.Duration {
+	wait := s.settings.LoginBackoff
+	for i := 1; i < failures && wait < s.settings.MaxLoginBackoff; i++ {
+		wait *= 2
+	}
+	if wait > s.settings.MaxLoginBackoff {
+		wait = s.settings.MaxLoginBackoff
+	}
+	return wait
+}
+
+// recordLoginFailure counts a failed login against each counter and locks
+// those that reach their limit. user is nil when the email is unknown.
+func (s *authService) recordLoginFailure(ctx context.Context, keys []attemptKey, req *domain.LoginRequest, user *domain.User) {
+	now := time.Now()
+
+	for _, k := range keys {
+		attempts, err := s.attemptRepo.RecordFailure(ctx, k.scope, k.key, now.Add(-s.settings.LockoutDuration))
+		if err != nil {
+			log.Printf("Failed to record login failure for %s %s: %v", k.scope, k.key, err)
+			continue
+		}
+
+		limit, event := s.settings.MaxAccountFailures, domain.AuditAccountLocked
+		if k.scope == domain.ScopeIP {
+			limit, event = s.settings.MaxIPFailures, domain.AuditIPLocked
+		}
+		if attempts.Failures < limit || attempts.LockedUntil != nil {
+			continue
+		}
+
+		until := now.Add(s.settings.LockoutDuration)
+		if err := s.attemptRepo.Lock(ctx, k.scope, k.key, until); err != nil {
+			log.Printf("Failed to lock %s %s: %v", k.scope, k.key, err)
+			continue
+		}
+
+		entry := &domain.AuditEntry{
+			Event:     event,
+			Email:     req.Email,
+			IPAddress: req.IPAddress,
+			Details:   fmt.Sprintf("%d failed login attempts, locked until %s", attempts.Failures, until.UTC().Format(time.RFC3339)),
+		}
+		if user != nil {
+			entry.UserID = user.ID
+		}
+		if err := s.auditRepo.Record(ctx, entry); err != nil {
+			log.Printf("Failed to record audit entry: %v", err)
+		}
+	}
+}
@@ -26,6 +26,11 @@ func NewJWTUtil(secret string, expiry time.Duration) *JWTUtil {
 	}
 }
 
+// Expiry returns how long generated tokens are valid for
+func (j *JWTUtil) Expiry() time.Duration {
+	return j.expiry
+}
+
 // GenerateToken creates a new JWT token for a user
 func (j *JWTUtil) GenerateToken(userID, email, role string) (string, error) {
 	claims := &Claims{
@@ -0,0 +1,44 @@
+-- Migration: Ball-by-ball Scoring
+-- Description: Delivery events recorded by scorers during a live match
+
+CREATE TABLE IF NOT EXISTS match_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+
+    -- Position in the match
+    innings INTEGER NOT NULL,
+    sequence INTEGER NOT NULL, -- Order of the delivery within the match
+    over_number INTEGER NOT NULL, -- 0-based over index within the innings
+    ball_number INTEGER NOT NULL, -- 1-6, extras re-use the number of the next legal ball
+
+    -- Participants
+    batting_team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    bowling_team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    striker_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    non_striker_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    bowler_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+
+    -- Runs
+    runs_off_bat INTEGER NOT NULL DEFAULT 0,
+    extras INTEGER NOT NULL DEFAULT 0,
+    extra_type VARCHAR(20), -- wide, no_ball, bye, leg_bye, penalty
+
+    -- Wicket
+    is_wicket BOOLEAN NOT NULL DEFAULT false,
+    wicket_type VARCHAR(50), -- bowled, caught, lbw, run_out, stumped, hit_wicket, etc.
+    dismissed_player_id UUID REFERENCES players(id) ON DELETE SET NULL,
+    fielder_id UUID REFERENCES players(id) ON DELETE SET NULL,
+
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE(match_id, sequence),
+    CONSTRAINT valid_innings CHECK (innings >= 1),
+    CONSTRAINT valid_runs CHECK (runs_off_bat >= 0 AND extras >= 0),
+    CONSTRAINT valid_extra_type CHECK (extra_type IN ('wide', 'no_ball', 'bye', 'leg_bye', 'penalty')),
+    CONSTRAINT valid_wicket_type CHECK (wicket_type IN ('bowled', 'caught', 'lbw', 'run_out', 'stumped', 'hit_wicket', 'retired_hurt', 'timed_out', 'obstructing', 'hit_twice'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_deliveries_match ON match_deliveries(match_id, innings, sequence);
+CREATE INDEX IF NOT EXISTS idx_match_deliveries_striker ON match_deliveries(striker_id);
+CREATE INDEX IF NOT EXISTS idx_match_deliveries_bowler ON match_deliveries(bowler_id);
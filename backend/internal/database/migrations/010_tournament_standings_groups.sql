@@ -0,0 +1,6 @@
+-- Migration: Tournament Standings Groups
+-- Description: Track the group a standing belongs to so positions can be assigned per group
+
+ALTER TABLE tournament_standings ADD COLUMN IF NOT EXISTS group_name VARCHAR(50);
+
+CREATE INDEX IF NOT EXISTS idx_tournament_standings_group ON tournament_standings(tournament_id, group_name, position);
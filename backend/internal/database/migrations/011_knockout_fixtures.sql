@@ -0,0 +1,5 @@
+-- Migration: Knockout Fixtures
+-- Description: Allow generated knockout matches to wait for the winners of earlier rounds
+
+ALTER TABLE matches ALTER COLUMN team_a_id DROP NOT NULL;
+ALTER TABLE matches ALTER COLUMN team_b_id DROP NOT NULL;
@@ -0,0 +1,7 @@
+-- Migration: Knockout Progression
+-- Description: Record who won each knockout match and how ties were resolved
+
+ALTER TABLE tournament_matches ADD COLUMN IF NOT EXISTS winner_team_id UUID REFERENCES teams(id) ON DELETE SET NULL;
+ALTER TABLE tournament_matches ADD COLUMN IF NOT EXISTS resolution VARCHAR(20); -- result, super_over, higher_seed, coin_toss
+
+CREATE INDEX IF NOT EXISTS idx_tournament_matches_next ON tournament_matches(next_match_id);
@@ -0,0 +1,23 @@
+-- Migration: Ground Availability
+-- Description: Opening hours, slot granularity and blackout dates for grounds,
+-- and double-booking protection that ignores cancelled bookings
+
+ALTER TABLE grounds ADD COLUMN IF NOT EXISTS opening_time TIME NOT NULL DEFAULT '06:00';
+ALTER TABLE grounds ADD COLUMN IF NOT EXISTS closing_time TIME NOT NULL DEFAULT '22:00';
+ALTER TABLE grounds ADD COLUMN IF NOT EXISTS slot_minutes INT NOT NULL DEFAULT 60;
+
+CREATE TABLE IF NOT EXISTS ground_blackout_dates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    ground_id UUID NOT NULL REFERENCES grounds(id) ON DELETE CASCADE,
+    blackout_date DATE NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(ground_id, blackout_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ground_blackout_dates_ground ON ground_blackout_dates(ground_id, blackout_date);
+
+-- A cancelled booking must not block the slot it used to hold
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_ground_id_booking_date_start_time_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bookings_active_slot ON bookings(ground_id, booking_date, start_time)
+    WHERE status IN ('pending', 'confirmed');
@@ -0,0 +1,10 @@
+-- Migration: Booking Lifecycle
+-- Description: Cancellation details and refund status for ground bookings
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS refund_status VARCHAR(20); -- eligible, not_eligible
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS cancelled_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS cancellation_reason TEXT;
+
+-- Used by the sweep that completes bookings once they are over
+CREATE INDEX IF NOT EXISTS idx_bookings_status_date ON bookings(status, booking_date);
@@ -0,0 +1,22 @@
+-- Migration: Reviews
+-- Description: Ratings and reviews of grounds and physiotherapists, one per
+-- completed booking or appointment, with a reply from the owner
+
+CREATE TABLE IF NOT EXISTS reviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    subject_type VARCHAR(20) NOT NULL CHECK (subject_type IN ('ground', 'physiotherapist')),
+    subject_id UUID NOT NULL, -- grounds.id or physiotherapists.id
+    booking_id UUID UNIQUE REFERENCES bookings(id) ON DELETE CASCADE,
+    appointment_id UUID UNIQUE REFERENCES appointments(id) ON DELETE CASCADE,
+    reviewer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rating INT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment TEXT,
+    reply TEXT,
+    replied_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((booking_id IS NULL) <> (appointment_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviews_subject ON reviews(subject_type, subject_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_reviews_reviewer ON reviews(reviewer_id);
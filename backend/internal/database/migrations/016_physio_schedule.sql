@@ -0,0 +1,69 @@
+-- Migration: Physiotherapist Schedule
+-- Description: Weekly working hours with breaks, leave days and slot length for
+-- physiotherapists, and overlap protection that ignores cancelled appointments
+
+ALTER TABLE physiotherapists ADD COLUMN IF NOT EXISTS slot_minutes INT NOT NULL DEFAULT 60;
+
+CREATE TABLE IF NOT EXISTS physio_working_hours (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    physiotherapist_id UUID NOT NULL REFERENCES physiotherapists(id) ON DELETE CASCADE,
+    day_of_week SMALLINT NOT NULL CHECK (day_of_week BETWEEN 0 AND 6), -- 0 = Sunday
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    break_start TIME,
+    break_end TIME,
+    UNIQUE(physiotherapist_id, day_of_week),
+    CHECK (end_time > start_time),
+    CHECK ((break_start IS NULL) = (break_end IS NULL))
+);
+
+CREATE TABLE IF NOT EXISTS physio_leave_days (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    physiotherapist_id UUID NOT NULL REFERENCES physiotherapists(id) ON DELETE CASCADE,
+    leave_date DATE NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(physiotherapist_id, leave_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_physio_leave_days ON physio_leave_days(physiotherapist_id, leave_date);
+
+-- Seed working hours from the free text fields. available_hours in the form
+-- HH:MM-HH:MM is kept, anything else becomes 09:00-17:00.
+INSERT INTO physio_working_hours (physiotherapist_id, day_of_week, start_time, end_time)
+SELECT physiotherapist_id, day_of_week, start_time, end_time
+FROM (
+    SELECT p.id AS physiotherapist_id,
+           CASE lower(trim(d.day))
+               WHEN 'sunday' THEN 0 WHEN 'monday' THEN 1 WHEN 'tuesday' THEN 2
+               WHEN 'wednesday' THEN 3 WHEN 'thursday' THEN 4 WHEN 'friday' THEN 5
+               WHEN 'saturday' THEN 6
+           END AS day_of_week,
+           CASE WHEN p.available_hours ~ '^\s*\d{1,2}:\d{2}\s*-\s*\d{1,2}:\d{2}\s*$'
+                THEN trim(split_part(p.available_hours, '-', 1))::time ELSE '09:00'::time END AS start_time,
+           CASE WHEN p.available_hours ~ '^\s*\d{1,2}:\d{2}\s*-\s*\d{1,2}:\d{2}\s*$'
+                THEN trim(split_part(p.available_hours, '-', 2))::time ELSE '17:00'::time END AS end_time
+    FROM physiotherapists p
+    CROSS JOIN LATERAL unnest(p.available_days) AS d(day)
+    WHERE lower(trim(d.day)) IN ('sunday', 'monday', 'tuesday', 'wednesday', 'thursday', 'friday', 'saturday')
+) hours
+WHERE end_time > start_time
+ON CONFLICT (physiotherapist_id, day_of_week) DO NOTHING;
+
+-- Only scheduled appointments hold a slot. The generated name of the old
+-- unique constraint is truncated, so look it up rather than guess it.
+DO $$
+DECLARE
+    constraint_name TEXT;
+BEGIN
+    SELECT conname INTO constraint_name
+    FROM pg_constraint
+    WHERE conrelid = 'appointments'::regclass AND contype = 'u';
+
+    IF constraint_name IS NOT NULL THEN
+        EXECUTE format('ALTER TABLE appointments DROP CONSTRAINT %I', constraint_name);
+    END IF;
+END $$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_appointments_active_slot ON appointments(physiotherapist_id, appointment_date, appointment_time)
+    WHERE status = 'scheduled';
@@ -0,0 +1,18 @@
+-- Migration: Appointment Lifecycle
+-- Description: Status history for appointments and a link from a rescheduled
+-- appointment to the one that replaced it
+
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS rescheduled_from UUID REFERENCES appointments(id) ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS appointment_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    appointment_id UUID NOT NULL REFERENCES appointments(id) ON DELETE CASCADE,
+    from_status VARCHAR(20), -- NULL when the appointment was created
+    to_status VARCHAR(20) NOT NULL,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_status_history ON appointment_status_history(appointment_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_appointments_physio_date ON appointments(physiotherapist_id, appointment_date, status);
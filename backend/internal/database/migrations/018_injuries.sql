@@ -0,0 +1,42 @@
+-- Migration: Injuries and Rehabilitation
+-- Description: Injury records for team players, rehab plans run by a
+-- physiotherapist and rehab sessions taken from physio appointments
+
+CREATE TABLE IF NOT EXISTS player_injuries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    body_part VARCHAR(50) NOT NULL,
+    severity VARCHAR(20) NOT NULL CHECK (severity IN ('minor', 'moderate', 'severe')),
+    injury_date DATE NOT NULL,
+    expected_return_date DATE,
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'recovering', 'recovered')),
+    description TEXT,
+    reported_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS rehab_plans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    injury_id UUID NOT NULL REFERENCES player_injuries(id) ON DELETE CASCADE,
+    physiotherapist_id UUID NOT NULL REFERENCES physiotherapists(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    goals TEXT,
+    start_date DATE NOT NULL,
+    end_date DATE,
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'completed')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS rehab_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    plan_id UUID NOT NULL REFERENCES rehab_plans(id) ON DELETE CASCADE,
+    appointment_id UUID NOT NULL UNIQUE REFERENCES appointments(id) ON DELETE CASCADE,
+    notes TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_injuries_player ON player_injuries(player_id, status);
+CREATE INDEX IF NOT EXISTS idx_rehab_plans_injury ON rehab_plans(injury_id);
+CREATE INDEX IF NOT EXISTS idx_rehab_sessions_plan ON rehab_sessions(plan_id);
@@ -0,0 +1,18 @@
+-- Migration: Physiotherapist Onboarding
+-- Description: Self-service applications from physio users and their review by
+-- admins. is_verified stays in step with an approved verification_status.
+
+ALTER TABLE physiotherapists ADD COLUMN IF NOT EXISTS verification_status VARCHAR(20) NOT NULL DEFAULT 'pending'
+    CHECK (verification_status IN ('pending', 'approved', 'rejected'));
+ALTER TABLE physiotherapists ADD COLUMN IF NOT EXISTS documents TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE physiotherapists ADD COLUMN IF NOT EXISTS rejection_reason TEXT;
+ALTER TABLE physiotherapists ADD COLUMN IF NOT EXISTS reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE physiotherapists ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMP;
+ALTER TABLE physiotherapists ADD COLUMN IF NOT EXISTS submitted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+-- Physiotherapists seeded before onboarding existed keep their verification
+UPDATE physiotherapists SET verification_status = 'approved' WHERE is_verified = true;
+
+-- A user holds a single physiotherapist profile, which is also their application
+CREATE UNIQUE INDEX IF NOT EXISTS idx_physiotherapists_user ON physiotherapists(user_id);
+CREATE INDEX IF NOT EXISTS idx_physiotherapists_verification ON physiotherapists(verification_status, submitted_at);
@@ -0,0 +1,10 @@
+-- Migration: Refresh Token Rotation
+-- Description: refresh_tokens.token now holds the SHA-256 hash of an opaque
+-- token. Tokens rotate within a family (one per login) so reuse of a rotated
+-- token can revoke the whole family.
+
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS family_id UUID NOT NULL DEFAULT gen_random_uuid();
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMP;
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS replaced_by UUID REFERENCES refresh_tokens(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family ON refresh_tokens(family_id);
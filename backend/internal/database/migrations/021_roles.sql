@@ -0,0 +1,15 @@
+-- Migration: Roles
+-- Description: Restrict users to the defined role set (player, team_manager,
+-- ground_owner, physio, organizer, admin). Roles from before the role set
+-- existed are mapped onto it.
+
+UPDATE users SET role = 'organizer' WHERE role = 'organiser';
+UPDATE users SET role = 'player' WHERE role IN ('umpire', 'commentator', 'streamer', 'personal_coach');
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'users_role_check') THEN
+        ALTER TABLE users ADD CONSTRAINT users_role_check
+            CHECK (role IN ('player', 'team_manager', 'ground_owner', 'physio', 'organizer', 'admin'));
+    END IF;
+END $$;
@@ -0,0 +1,25 @@
+-- Migration: Login Throttling
+-- Description: Failed login attempts per IP address and per account, used for
+-- backoff and temporary lockout, and an audit log of security events
+
+CREATE TABLE IF NOT EXISTS login_attempts (
+    scope VARCHAR(10) NOT NULL CHECK (scope IN ('ip', 'account')),
+    key VARCHAR(255) NOT NULL, -- IP address or lowercased email
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP,
+    PRIMARY KEY (scope, key)
+);
+
+CREATE TABLE IF NOT EXISTS auth_audit_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event VARCHAR(50) NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    email VARCHAR(255),
+    ip_address VARCHAR(64),
+    details TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_audit_log_user ON auth_audit_log(user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_auth_audit_log_event ON auth_audit_log(event, created_at);
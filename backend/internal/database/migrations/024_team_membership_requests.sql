@@ -0,0 +1,28 @@
+-- Migration: Team Membership Requests
+-- Description: Invitations from a team to a user and requests from a user to
+-- join a team. A player is only added once the other side agrees.
+
+CREATE TABLE IF NOT EXISTS team_membership_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('invitation', 'join_request')),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'accepted', 'declined', 'cancelled')),
+    requested_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    jersey_number INTEGER NOT NULL,
+    role VARCHAR(50) NOT NULL,
+    batting VARCHAR(50) NOT NULL,
+    bowling VARCHAR(50),
+    message TEXT,
+    responded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    responded_at TIMESTAMP,
+    player_id UUID REFERENCES players(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Only one open invitation or join request per user and team
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_membership_requests_pending
+    ON team_membership_requests(team_id, user_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_team_membership_requests_user ON team_membership_requests(user_id, status);
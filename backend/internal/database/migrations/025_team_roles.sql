@@ -0,0 +1,28 @@
+-- Migration: Team Roles
+-- Description: Per-team roles (owner, manager, captain, vice-captain) used to
+-- authorize team management, replacing checks against teams.created_by.
+-- teams.captain_id is kept in step with the captain role.
+
+CREATE TABLE IF NOT EXISTS team_roles (
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'manager', 'captain', 'vice_captain')),
+    assigned_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    assigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (team_id, user_id)
+);
+
+-- A team has one owner, one captain and one vice-captain
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_roles_single
+    ON team_roles(team_id, role) WHERE role IN ('owner', 'captain', 'vice_captain');
+CREATE INDEX IF NOT EXISTS idx_team_roles_user ON team_roles(user_id);
+
+-- Existing creators own their teams, existing captains keep the captaincy
+INSERT INTO team_roles (team_id, user_id, role)
+SELECT id, created_by, 'owner' FROM teams WHERE created_by IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO team_roles (team_id, user_id, role)
+SELECT id, captain_id, 'captain' FROM teams
+WHERE captain_id IS NOT NULL AND captain_id IS DISTINCT FROM created_by
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,79 @@
+-- Migration: Match Innings
+-- Description: An innings entity per match so multi-innings games (follow-on,
+-- declarations) and super overs of tied limited-overs games can be scored,
+-- and player performances split per innings. Deliveries keep referring to
+-- their innings by number.
+
+CREATE TABLE IF NOT EXISTS match_innings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    innings_number INTEGER NOT NULL,
+    batting_team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    bowling_team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+
+    is_super_over BOOLEAN NOT NULL DEFAULT false,
+    follow_on BOOLEAN NOT NULL DEFAULT false, -- batting again straight after its previous innings
+    declared BOOLEAN NOT NULL DEFAULT false,
+    max_overs INTEGER, -- NULL when the innings is not limited by overs
+    max_wickets INTEGER NOT NULL DEFAULT 10,
+    target INTEGER, -- runs needed to win, for the last innings of a match or super over
+
+    started_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE(match_id, innings_number),
+    CONSTRAINT valid_innings_number CHECK (innings_number >= 1),
+    CONSTRAINT valid_max_overs CHECK (max_overs IS NULL OR max_overs > 0)
+);
+
+-- Innings already scored ball by ball
+INSERT INTO match_innings (match_id, innings_number, batting_team_id, bowling_team_id, max_overs, target)
+SELECT d.match_id, d.innings, d.batting_team_id, d.bowling_team_id,
+       CASE WHEN m.match_format <> 'Test' AND m.total_overs > 0 THEN m.total_overs END,
+       CASE WHEN m.match_format <> 'Test' AND d.innings = 2 THEN (
+           SELECT SUM(f.runs_off_bat + f.extras) + 1 FROM match_deliveries f
+           WHERE f.match_id = d.match_id AND f.innings = 1
+       ) END
+FROM (
+    SELECT DISTINCT ON (match_id, innings) match_id, innings, batting_team_id, bowling_team_id
+    FROM match_deliveries
+    ORDER BY match_id, innings, sequence
+) d
+JOIN matches m ON m.id = d.match_id
+ON CONFLICT (match_id, innings_number) DO NOTHING;
+
+-- Player performances per innings, alongside the match totals
+CREATE TABLE IF NOT EXISTS player_innings_performances (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    innings_number INTEGER NOT NULL,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    is_super_over BOOLEAN NOT NULL DEFAULT false,
+
+    -- Batting
+    batting_position INTEGER,
+    runs_scored INTEGER DEFAULT 0,
+    balls_faced INTEGER DEFAULT 0,
+    fours INTEGER DEFAULT 0,
+    sixes INTEGER DEFAULT 0,
+    dismissal_type VARCHAR(50),
+    dismissed_by_player_id UUID REFERENCES players(id) ON DELETE SET NULL,
+
+    -- Bowling
+    overs_bowled DECIMAL(10,1) DEFAULT 0.0,
+    runs_conceded INTEGER DEFAULT 0,
+    wickets_taken INTEGER DEFAULT 0,
+    maidens INTEGER DEFAULT 0,
+
+    -- Fielding
+    catches INTEGER DEFAULT 0,
+    run_outs INTEGER DEFAULT 0,
+    stumpings INTEGER DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE(player_id, match_id, innings_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_innings_performances_match ON player_innings_performances(match_id, innings_number);
@@ -0,0 +1,15 @@
+-- Migration: Retired Out
+-- Description: Allow the retired_out dismissal, which the match rules profile
+-- permits per format and tournament. Unlike retired_hurt it counts as a wicket.
+
+ALTER TABLE match_deliveries DROP CONSTRAINT IF EXISTS valid_wicket_type;
+ALTER TABLE match_deliveries ADD CONSTRAINT valid_wicket_type CHECK (wicket_type IN (
+    'bowled', 'caught', 'lbw', 'run_out', 'stumped', 'hit_wicket', 'retired_hurt', 'retired_out',
+    'timed_out', 'obstructing', 'hit_twice'
+));
+
+ALTER TABLE player_match_performances DROP CONSTRAINT IF EXISTS valid_dismissal_type;
+ALTER TABLE player_match_performances ADD CONSTRAINT valid_dismissal_type CHECK (dismissal_type IN (
+    'bowled', 'caught', 'lbw', 'run_out', 'stumped', 'hit_wicket', 'retired_hurt', 'retired_out',
+    'not_out', 'timed_out', 'obstructing', 'hit_twice'
+));
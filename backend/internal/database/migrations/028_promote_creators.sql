@@ -0,0 +1,14 @@
+-- Migration: Promote Creators
+-- Description: Give users who organize tournaments or own teams from before
+-- roles were enforced the role now required to create them. Organizers become
+-- organizers and team owners (seeded from teams.created_by) team managers.
+-- Only players and team managers are promoted, so admins keep their role and
+-- ground owners and physios keep the role their own features depend on.
+
+UPDATE users SET role = 'organizer'
+WHERE role IN ('player', 'team_manager')
+  AND id IN (SELECT organizer_id FROM tournaments);
+
+UPDATE users SET role = 'team_manager'
+WHERE role = 'player'
+  AND id IN (SELECT user_id FROM team_roles WHERE role = 'owner');
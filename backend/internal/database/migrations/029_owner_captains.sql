@@ -0,0 +1,12 @@
+-- Migration: Owner Captains
+-- Description: A team with no captain appointed is captained by its owner when
+-- they play for it. Team creators who were also captain could not be given the
+-- captain role alongside ownership, so restore them as captain where role
+-- changes had cleared teams.captain_id.
+
+UPDATE teams t SET captain_id = tr.user_id
+FROM team_roles tr
+JOIN players p ON p.team_id = tr.team_id AND p.user_id = tr.user_id AND p.is_active = true
+WHERE tr.team_id = t.id AND tr.role = 'owner'
+  AND t.captain_id IS NULL
+  AND NOT EXISTS (SELECT 1 FROM team_roles c WHERE c.team_id = t.id AND c.role = 'captain');
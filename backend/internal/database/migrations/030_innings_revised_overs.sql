@@ -0,0 +1,14 @@
+-- Migration: Innings Revised Overs
+-- Description: Record when an interruption shortens a limited-overs innings.
+-- The revised overs replace max_overs for the rest of the innings, and a
+-- shortened chase has its target scaled to them.
+
+ALTER TABLE match_innings ADD COLUMN IF NOT EXISTS revised_overs INTEGER;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'valid_revised_overs') THEN
+        ALTER TABLE match_innings ADD CONSTRAINT valid_revised_overs
+            CHECK (revised_overs IS NULL OR (revised_overs > 0 AND revised_overs < max_overs));
+    END IF;
+END $$;
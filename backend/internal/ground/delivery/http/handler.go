@@ -1,35 +1,38 @@
 package http
 
 import (
-	"database/sql"
 	"encoding/json"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/cricketapp/backend/internal/ground/domain"
-	"github.com/cricketapp/backend/internal/ground/repository/postgres"
-	"github.com/cricketapp/backend/internal/ground/service"
 	"github.com/go-chi/chi/v5"
 )
 
+// maxImageSize is the largest ground image accepted for upload
+const maxImageSize = 5 << 20
+
 type GroundHandler struct {
 	groundService domain.GroundService
 }
 
-func NewGroundHandler(db *sql.DB) *GroundHandler {
-	groundRepo := postgres.NewGroundRepository(db)
-	groundService := service.NewGroundService(groundRepo)
-
+func NewGroundHandler(groundService domain.GroundService) *GroundHandler {
 	return &GroundHandler{
 		groundService: groundService,
 	}
 }
 
 // ListGrounds handles GET /api/v1/grounds
+// Query: near=lat,lng, radius_km, min_price, max_price, facilities=a,b,
+// min_rating, date, start_time, end_time, sort (rating|distance|price|price_desc)
 func (h *GroundHandler) ListGrounds(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
 	// Parse query parameters
-	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
-	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	page, _ := strconv.Atoi(q.Get("page"))
+	limit, _ := strconv.Atoi(q.Get("limit"))
 
 	if page < 1 {
 		page = 1
@@ -38,9 +41,55 @@ func (h *GroundHandler) ListGrounds(w http.ResponseWriter, r *http.Request) {
 		limit = 10
 	}
 
-	response, err := h.groundService.ListGrounds(r.Context(), page, limit)
+	filters := domain.GroundFilters{
+		SortBy: q.Get("sort"),
+		Page:   page,
+		Limit:  limit,
+	}
+
+	if near := q.Get("near"); near != "" {
+		parts := strings.Split(near, ",")
+		if len(parts) != 2 {
+			respondError(w, http.StatusBadRequest, "near must be lat,lng")
+			return
+		}
+		lat, latErr := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+		lng, lngErr := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if latErr != nil || lngErr != nil {
+			respondError(w, http.StatusBadRequest, "near must be lat,lng")
+			return
+		}
+		filters.Latitude, filters.Longitude = &lat, &lng
+	}
+
+	var err error
+	for param, dest := range map[string]**float64{
+		"radius_km":  &filters.RadiusKm,
+		"min_price":  &filters.MinPrice,
+		"max_price":  &filters.MaxPrice,
+		"min_rating": &filters.MinRating,
+	} {
+		if *dest, err = floatParam(q.Get(param)); err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid "+param)
+			return
+		}
+	}
+
+	if facilities := q.Get("facilities"); facilities != "" {
+		for _, facility := range strings.Split(facilities, ",") {
+			if facility = strings.TrimSpace(facility); facility != "" {
+				filters.Facilities = append(filters.Facilities, facility)
+			}
+		}
+	}
+
+	filters.Date = stringParam(q.Get("date"))
+	filters.StartTime = stringParam(q.Get("start_time"))
+	filters.EndTime = stringParam(q.Get("end_time"))
+
+	response, err := h.groundService.ListGrounds(r.Context(), filters)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, err.Error())
+		respondError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -70,6 +119,191 @@ func (h *GroundHandler) GetGroundDetails(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// ListMyGrounds handles GET /api/v1/grounds/my
+func (h *GroundHandler) ListMyGrounds(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	grounds, err := h.groundService.ListMyGrounds(r.Context(), userID.(string))
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   grounds,
+	})
+}
+
+// CreateGround handles POST /api/v1/grounds
+func (h *GroundHandler) CreateGround(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	role, _ := r.Context().Value("user_role").(string)
+
+	var req domain.CreateGroundRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	ground, err := h.groundService.CreateGround(r.Context(), userID.(string), role, &req)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, map[string]interface{}{
+		"status":  "success",
+		"data":    ground,
+		"message": "Ground created successfully",
+	})
+}
+
+// UpdateGround handles PUT /api/v1/grounds/:id
+func (h *GroundHandler) UpdateGround(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req domain.UpdateGroundRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	ground, err := h.groundService.UpdateGround(r.Context(), userID.(string), chi.URLParam(r, "id"), &req)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"data":    ground,
+		"message": "Ground updated successfully",
+	})
+}
+
+// DeactivateGround handles PUT /api/v1/grounds/:id/deactivate
+func (h *GroundHandler) DeactivateGround(w http.ResponseWriter, r *http.Request) {
+	h.setGroundActive(w, r, false)
+}
+
+// ActivateGround handles PUT /api/v1/grounds/:id/activate
+func (h *GroundHandler) ActivateGround(w http.ResponseWriter, r *http.Request) {
+	h.setGroundActive(w, r, true)
+}
+
+func (h *GroundHandler) setGroundActive(w http.ResponseWriter, r *http.Request, active bool) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	ground, err := h.groundService.SetGroundActive(r.Context(), userID.(string), chi.URLParam(r, "id"), active)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	message := "Ground deactivated successfully"
+	if active {
+		message = "Ground activated successfully"
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"data":    ground,
+		"message": message,
+	})
+}
+
+// UploadGroundImage handles POST /api/v1/grounds/:id/images (multipart field "image")
+func (h *GroundHandler) UploadGroundImage(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImageSize+1024*1024)
+	if err := r.ParseMultipartForm(maxImageSize); err != nil {
+		respondError(w, http.StatusBadRequest, "Image must be at most 5MB")
+		return
+	}
+
+	file, header, err := r.FormFile("image")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Image file is required")
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxImageSize {
+		respondError(w, http.StatusBadRequest, "Image must be at most 5MB")
+		return
+	}
+
+	// Detect the type from the content rather than trusting the client
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(file, head)
+	contentType := http.DetectContentType(head[:n])
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid image file")
+		return
+	}
+
+	ground, err := h.groundService.UploadGroundImage(r.Context(), userID.(string), chi.URLParam(r, "id"), contentType, file)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, map[string]interface{}{
+		"status":  "success",
+		"data":    ground,
+		"message": "Image uploaded successfully",
+	})
+}
+
+// DeleteGroundImage handles DELETE /api/v1/grounds/:id/images?url=
+func (h *GroundHandler) DeleteGroundImage(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	imageURL := r.URL.Query().Get("url")
+	if imageURL == "" {
+		respondError(w, http.StatusBadRequest, "Image URL is required")
+		return
+	}
+
+	ground, err := h.groundService.DeleteGroundImage(r.Context(), userID.(string), chi.URLParam(r, "id"), imageURL)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"data":    ground,
+		"message": "Image removed successfully",
+	})
+}
+
 // CreateBooking handles POST /api/v1/bookings
 func (h *GroundHandler) CreateBooking(w http.ResponseWriter, r *http.Request) {
 	userID := r.Context().Value("user_id")
@@ -117,6 +351,217 @@ func (h *GroundHandler) GetUserBookings(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// GetAvailability handles GET /api/v1/grounds/:id/availability
+func (h *GroundHandler) GetAvailability(w http.ResponseWriter, r *http.Request) {
+	groundID := chi.URLParam(r, "id")
+	if groundID == "" {
+		respondError(w, http.StatusBadRequest, "Ground ID is required")
+		return
+	}
+
+	availability, err := h.groundService.GetAvailability(r.Context(), groundID, r.URL.Query().Get("from"), r.URL.Query().Get("to"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   availability,
+	})
+}
+
+// UpdateAvailability handles PUT /api/v1/grounds/:id/availability
+func (h *GroundHandler) UpdateAvailability(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req domain.UpdateAvailabilityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	ground, err := h.groundService.UpdateAvailability(r.Context(), userID.(string), chi.URLParam(r, "id"), &req)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"data":    ground,
+		"message": "Availability updated successfully",
+	})
+}
+
+// AddBlackoutDate handles POST /api/v1/grounds/:id/blackouts
+func (h *GroundHandler) AddBlackoutDate(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req domain.AddBlackoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	blackout, err := h.groundService.AddBlackoutDate(r.Context(), userID.(string), chi.URLParam(r, "id"), &req)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, map[string]interface{}{
+		"status":  "success",
+		"data":    blackout,
+		"message": "Blackout date added successfully",
+	})
+}
+
+// DeleteBlackoutDate handles DELETE /api/v1/grounds/:id/blackouts/:blackoutId
+func (h *GroundHandler) DeleteBlackoutDate(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	err := h.groundService.DeleteBlackoutDate(r.Context(), userID.(string), chi.URLParam(r, "id"), chi.URLParam(r, "blackoutId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"message": "Blackout date removed successfully",
+	})
+}
+
+// ListOwnerBookings handles GET /api/v1/bookings/owner
+func (h *GroundHandler) ListOwnerBookings(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	bookings, err := h.groundService.ListOwnerBookings(r.Context(), userID.(string), r.URL.Query().Get("status"))
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   bookings,
+	})
+}
+
+// ConfirmBooking handles POST /api/v1/bookings/:id/confirm
+func (h *GroundHandler) ConfirmBooking(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	booking, err := h.groundService.ConfirmBooking(r.Context(), userID.(string), chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"data":    booking,
+		"message": "Booking confirmed successfully",
+	})
+}
+
+// RejectBooking handles POST /api/v1/bookings/:id/reject
+func (h *GroundHandler) RejectBooking(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req domain.BookingActionRequest
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	booking, err := h.groundService.RejectBooking(r.Context(), userID.(string), chi.URLParam(r, "id"), &req)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"data":    booking,
+		"message": "Booking rejected",
+	})
+}
+
+// CancelBooking handles POST /api/v1/bookings/:id/cancel
+func (h *GroundHandler) CancelBooking(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id")
+	if userID == nil {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req domain.BookingActionRequest
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	booking, err := h.groundService.CancelBooking(r.Context(), userID.(string), chi.URLParam(r, "id"), &req)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "success",
+		"data":    booking,
+		"message": "Booking cancelled successfully",
+	})
+}
+
+func floatParam(value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func stringParam(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
 func respondJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
@@ -16,28 +16,90 @@ type Ground struct {
 	HalfDayPrice float64   `json:"half_day_price"`
 	FullDayPrice float64   `json:"full_day_price"`
 	Images       []string  `json:"images,omitempty"`
+	OpeningTime  string    `json:"opening_time"` // HH:MM format
+	ClosingTime  string    `json:"closing_time"` // HH:MM format
+	SlotMinutes  int       `json:"slot_minutes"`
 	Rating       float64   `json:"rating"`
 	TotalReviews int       `json:"total_reviews"`
 	IsActive     bool      `json:"is_active"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
+
+	// DistanceKm is set when searching near a location
+	DistanceKm *float64 `json:"distance_km,omitempty"`
 }
 
+// Ground search sort orders
+const (
+	SortByRating    = "rating"
+	SortByDistance  = "distance"
+	SortByPrice     = "price"
+	SortByPriceDesc = "price_desc"
+)
+
 // Booking represents a ground booking
 type Booking struct {
-	ID            string    `json:"id"`
-	GroundID      string    `json:"ground_id"`
-	UserID        string    `json:"user_id"`
-	BookingDate   string    `json:"booking_date"`  // YYYY-MM-DD format
-	StartTime     string    `json:"start_time"`    // HH:MM format
-	EndTime       string    `json:"end_time"`      // HH:MM format
-	DurationType  string    `json:"duration_type"` // hourly, half_day, full_day
-	TotalPrice    float64   `json:"total_price"`
-	Status        string    `json:"status"`         // pending, confirmed, cancelled, completed
-	PaymentStatus string    `json:"payment_status"` // pending, paid, refunded
-	Notes         string    `json:"notes,omitempty"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID            string  `json:"id"`
+	GroundID      string  `json:"ground_id"`
+	UserID        string  `json:"user_id"`
+	BookingDate   string  `json:"booking_date"`  // YYYY-MM-DD format
+	StartTime     string  `json:"start_time"`    // HH:MM format
+	EndTime       string  `json:"end_time"`      // HH:MM format
+	DurationType  string  `json:"duration_type"` // hourly, half_day, full_day
+	TotalPrice    float64 `json:"total_price"`
+	Status        string  `json:"status"`         // pending, confirmed, rejected, cancelled, completed
+	PaymentStatus string  `json:"payment_status"` // pending, paid, refunded
+	Notes         string  `json:"notes,omitempty"`
+
+	RefundStatus       string     `json:"refund_status,omitempty"` // eligible, not_eligible
+	CancelledBy        string     `json:"cancelled_by,omitempty"`
+	CancelledAt        *time.Time `json:"cancelled_at,omitempty"`
+	CancellationReason string     `json:"cancellation_reason,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Booking statuses
+const (
+	BookingPending   = "pending"
+	BookingConfirmed = "confirmed"
+	BookingRejected  = "rejected"
+	BookingCancelled = "cancelled"
+	BookingCompleted = "completed"
+)
+
+// Refund outcomes of a cancelled or rejected booking
+const (
+	RefundEligible    = "eligible"
+	RefundNotEligible = "not_eligible"
+)
+
+// bookingTransitions lists the statuses a booking may move to from each status
+var bookingTransitions = map[string][]string{
+	BookingPending:   {BookingConfirmed, BookingRejected, BookingCancelled},
+	BookingConfirmed: {BookingCancelled, BookingCompleted},
+}
+
+// CanTransition reports whether a booking may move from one status to another
+func CanTransition(from, to string) bool {
+	for _, next := range bookingTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// BookingActionRequest carries the optional reason for rejecting or cancelling a booking
+type BookingActionRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// OwnerBooking is a booking of one of the caller's grounds
+type OwnerBooking struct {
+	Booking
+	GroundName string `json:"ground_name"`
 }
 
 // CreateBookingRequest represents booking creation request
@@ -50,6 +112,105 @@ type CreateBookingRequest struct {
 	Notes        string `json:"notes,omitempty"`
 }
 
+// CreateGroundRequest represents a new ground listed by its owner
+type CreateGroundRequest struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	Address      string   `json:"address"`
+	Latitude     float64  `json:"latitude,omitempty"`
+	Longitude    float64  `json:"longitude,omitempty"`
+	Facilities   []string `json:"facilities,omitempty"`
+	HourlyPrice  float64  `json:"hourly_price"`
+	HalfDayPrice float64  `json:"half_day_price"`
+	FullDayPrice float64  `json:"full_day_price"`
+	Images       []string `json:"images,omitempty"`
+	OpeningTime  string   `json:"opening_time,omitempty"` // HH:MM, defaults to 06:00
+	ClosingTime  string   `json:"closing_time,omitempty"` // HH:MM, defaults to 22:00
+	SlotMinutes  int      `json:"slot_minutes,omitempty"` // defaults to 60
+}
+
+// UpdateGroundRequest represents changes to a ground; omitted fields are kept
+type UpdateGroundRequest struct {
+	Name         *string   `json:"name,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	Address      *string   `json:"address,omitempty"`
+	Latitude     *float64  `json:"latitude,omitempty"`
+	Longitude    *float64  `json:"longitude,omitempty"`
+	Facilities   *[]string `json:"facilities,omitempty"`
+	HourlyPrice  *float64  `json:"hourly_price,omitempty"`
+	HalfDayPrice *float64  `json:"half_day_price,omitempty"`
+	FullDayPrice *float64  `json:"full_day_price,omitempty"`
+	Images       *[]string `json:"images,omitempty"`
+	OpeningTime  *string   `json:"opening_time,omitempty"`
+	ClosingTime  *string   `json:"closing_time,omitempty"`
+	SlotMinutes  *int      `json:"slot_minutes,omitempty"`
+}
+
+// Facilities a ground can list
+var ValidFacilities = map[string]bool{
+	"floodlights":    true,
+	"pavilion":       true,
+	"parking":        true,
+	"wifi":           true,
+	"changing_rooms": true,
+	"scoreboard":     true,
+	"cafe":           true,
+	"first_aid":      true,
+	"seating":        true,
+	"nets":           true,
+	"drinking_water": true,
+	"washrooms":      true,
+	"equipment":      true,
+}
+
+// BlackoutDate is a day on which a ground cannot be booked
+type BlackoutDate struct {
+	ID        string    `json:"id"`
+	GroundID  string    `json:"ground_id"`
+	Date      string    `json:"date"` // YYYY-MM-DD format
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UpdateAvailabilityRequest represents a change to a ground's opening hours
+type UpdateAvailabilityRequest struct {
+	OpeningTime string `json:"opening_time"` // HH:MM
+	ClosingTime string `json:"closing_time"` // HH:MM
+	SlotMinutes int    `json:"slot_minutes"`
+}
+
+// AddBlackoutRequest represents a request to block a date
+type AddBlackoutRequest struct {
+	Date   string `json:"date"` // YYYY-MM-DD
+	Reason string `json:"reason,omitempty"`
+}
+
+// Slot is a bookable window on a given day
+type Slot struct {
+	StartTime string `json:"start_time"` // HH:MM format
+	EndTime   string `json:"end_time"`   // HH:MM format
+	Status    string `json:"status"`     // free, booked, past
+}
+
+// DayAvailability lists the slots of a single day
+type DayAvailability struct {
+	Date           string `json:"date"` // YYYY-MM-DD format
+	IsBlackout     bool   `json:"is_blackout"`
+	BlackoutReason string `json:"blackout_reason,omitempty"`
+	Slots          []Slot `json:"slots"`
+}
+
+// AvailabilityResponse represents a ground's calendar over a date range
+type AvailabilityResponse struct {
+	GroundID    string            `json:"ground_id"`
+	From        string            `json:"from"`
+	To          string            `json:"to"`
+	OpeningTime string            `json:"opening_time"`
+	ClosingTime string            `json:"closing_time"`
+	SlotMinutes int               `json:"slot_minutes"`
+	Days        []DayAvailability `json:"days"`
+}
+
 // GroundListResponse represents paginated ground list
 type GroundListResponse struct {
 	Grounds    []Ground   `json:"grounds"`
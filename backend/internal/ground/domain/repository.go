@@ -1,12 +1,50 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // GroundRepository defines ground data access interface
 type GroundRepository interface {
-	ListGrounds(ctx context.Context, page, limit int) ([]Ground, int, error)
+	ListGrounds(ctx context.Context, filters GroundFilters) ([]Ground, int, error)
 	GetGroundByID(ctx context.Context, groundID string) (*Ground, error)
+	ListGroundsByOwner(ctx context.Context, ownerID string) ([]Ground, error)
+	CreateGround(ctx context.Context, ground *Ground) error
+	UpdateGround(ctx context.Context, ground *Ground) error
+	SetGroundActive(ctx context.Context, groundID string, active bool) error
+	UpdateGroundImages(ctx context.Context, groundID string, images []string) error
 	CreateBooking(ctx context.Context, booking *Booking) error
 	GetBookingsByUser(ctx context.Context, userID string) ([]Booking, error)
 	GetBookingByID(ctx context.Context, bookingID string) (*Booking, error)
+	ListActiveBookings(ctx context.Context, groundID, from, to string) ([]Booking, error)
+	UpdateAvailability(ctx context.Context, groundID string, req *UpdateAvailabilityRequest) error
+	ListBlackoutDates(ctx context.Context, groundID, from, to string) ([]BlackoutDate, error)
+	AddBlackoutDate(ctx context.Context, blackout *BlackoutDate) error
+	DeleteBlackoutDate(ctx context.Context, groundID, blackoutID string) error
+	ListBookingsByOwner(ctx context.Context, ownerID, status string) ([]OwnerBooking, error)
+	UpdateBookingStatus(ctx context.Context, booking *Booking, fromStatus string) error
+	SweepPastBookings(ctx context.Context, now time.Time) (completed, expired int64, err error)
+}
+
+// GroundFilters contains filters for searching grounds
+type GroundFilters struct {
+	// Latitude and Longitude of the searcher; required for RadiusKm and distance sort
+	Latitude  *float64
+	Longitude *float64
+	RadiusKm  *float64
+
+	MinPrice   *float64 // hourly price
+	MaxPrice   *float64 // hourly price
+	Facilities []string // all must be present
+	MinRating  *float64
+
+	// Only grounds free on Date (YYYY-MM-DD), between StartTime and EndTime (HH:MM) when given
+	Date      *string
+	StartTime *string
+	EndTime   *string
+
+	SortBy string // rating, distance, price, price_desc
+	Page   int
+	Limit  int
 }
@@ -1,11 +1,29 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"io"
+)
 
 // GroundService defines ground business logic interface
 type GroundService interface {
-	ListGrounds(ctx context.Context, page, limit int) (*GroundListResponse, error)
+	ListGrounds(ctx context.Context, filters GroundFilters) (*GroundListResponse, error)
 	GetGroundDetails(ctx context.Context, groundID string) (*Ground, error)
+	ListMyGrounds(ctx context.Context, ownerID string) ([]Ground, error)
+	CreateGround(ctx context.Context, ownerID, role string, req *CreateGroundRequest) (*Ground, error)
+	UpdateGround(ctx context.Context, ownerID, groundID string, req *UpdateGroundRequest) (*Ground, error)
+	SetGroundActive(ctx context.Context, ownerID, groundID string, active bool) (*Ground, error)
+	UploadGroundImage(ctx context.Context, ownerID, groundID, contentType string, data io.Reader) (*Ground, error)
+	DeleteGroundImage(ctx context.Context, ownerID, groundID, url string) (*Ground, error)
 	CreateBooking(ctx context.Context, userID string, req *CreateBookingRequest) (*Booking, error)
 	GetUserBookings(ctx context.Context, userID string) ([]Booking, error)
+	GetAvailability(ctx context.Context, groundID, from, to string) (*AvailabilityResponse, error)
+	UpdateAvailability(ctx context.Context, userID, groundID string, req *UpdateAvailabilityRequest) (*Ground, error)
+	AddBlackoutDate(ctx context.Context, userID, groundID string, req *AddBlackoutRequest) (*BlackoutDate, error)
+	DeleteBlackoutDate(ctx context.Context, userID, groundID, blackoutID string) error
+	ListOwnerBookings(ctx context.Context, ownerID, status string) ([]OwnerBooking, error)
+	ConfirmBooking(ctx context.Context, ownerID, bookingID string) (*Booking, error)
+	RejectBooking(ctx context.Context, ownerID, bookingID string, req *BookingActionRequest) (*Booking, error)
+	CancelBooking(ctx context.Context, userID, bookingID string, req *BookingActionRequest) (*Booking, error)
+	SweepPastBookings(ctx context.Context) error
 }
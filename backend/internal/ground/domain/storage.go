@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+	"io"
+)
+
+// ImageStore saves uploaded ground images and returns the URL they are served from
+type ImageStore interface {
+	Save(ctx context.Context, groundID, contentType string, data io.Reader) (string, error)
+	// Check rejects URLs of images the store saved for a different ground
+	Check(groundID, url string) error
+	Delete(ctx context.Context, groundID, url string) error
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/ground/domain"
+	"github.com/lib/pq"
+)
+
+func (r *groundRepository) ListActiveBookings(ctx context.Context, groundID, from, to string) ([]domain.Booking, error) {
+	query := `
+		SELECT ` + bookingColumns + `
+		FROM bookings b
+		WHERE b.ground_id = $1 AND b.booking_date BETWEEN $2 AND $3
+		  AND b.status IN ('pending', 'confirmed')
+		ORDER BY b.booking_date ASC, b.start_time ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, groundID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bookings: %w", err)
+	}
+	defer rows.Close()
+
+	var bookings []domain.Booking
+	for rows.Next() {
+		var b domain.Booking
+		if err := scanBooking(rows, &b); err != nil {
+			return nil, fmt.Errorf("failed to scan booking: %w", err)
+		}
+		bookings = append(bookings, b)
+	}
+
+	return bookings, rows.Err()
+}
+
+func (r *groundRepository) UpdateAvailability(ctx context.Context, groundID string, req *domain.UpdateAvailabilityRequest) error {
+	query := `
+		UPDATE grounds
+		SET opening_time = $1, closing_time = $2, slot_minutes = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, query, req.OpeningTime, req.ClosingTime, req.SlotMinutes, groundID)
+	if err != nil {
+		return fmt.Errorf("failed to update availability: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update availability: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("ground not found")
+	}
+
+	return nil
+}
+
+func (r *groundRepository) ListBlackoutDates(ctx context.Context, groundID, from, to string) ([]domain.BlackoutDate, error) {
+	query := `
+		SELECT id, ground_id, to_char(blackout_date, 'YYYY-MM-DD'), reason, created_at
+		FROM ground_blackout_dates
+		WHERE ground_id = $1 AND blackout_date BETWEEN $2 AND $3
+		ORDER BY blackout_date ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, groundID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query blackout dates: %w", err)
+	}
+	defer rows.Close()
+
+	var blackouts []domain.BlackoutDate
+	for rows.Next() {
+		var b domain.BlackoutDate
+		var reason sql.NullString
+
+		if err := rows.Scan(&b.ID, &b.GroundID, &b.Date, &reason, &b.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan blackout date: %w", err)
+		}
+
+		if reason.Valid {
+			b.Reason = reason.String
+		}
+		blackouts = append(blackouts, b)
+	}
+
+	return blackouts, rows.Err()
+}
+
+func (r *groundRepository) AddBlackoutDate(ctx context.Context, blackout *domain.BlackoutDate) error {
+	query := `
+		INSERT INTO ground_blackout_dates (ground_id, blackout_date, reason)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, blackout.GroundID, blackout.Date, blackout.Reason).
+		Scan(&blackout.ID, &blackout.CreatedAt)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("this date is already blocked")
+		}
+		return fmt.Errorf("failed to add blackout date: %w", err)
+	}
+
+	return nil
+}
+
+func (r *groundRepository) DeleteBlackoutDate(ctx context.Context, groundID, blackoutID string) error {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM ground_blackout_dates WHERE id = $1 AND ground_id = $2",
+		blackoutID, groundID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete blackout date: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete blackout date: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("blackout date not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cricketapp/backend/internal/ground/domain"
+)
+
+// bookingColumns selects a booking with its date and times formatted the way
+// they are sent by clients
+const bookingColumns = `
+	b.id, b.ground_id, b.user_id, to_char(b.booking_date, 'YYYY-MM-DD'),
+	to_char(b.start_time, 'HH24:MI'), to_char(b.end_time, 'HH24:MI'),
+	b.duration_type, b.total_price, b.status, b.payment_status, b.notes,
+	b.refund_status, b.cancelled_by, b.cancelled_at, b.cancellation_reason,
+	b.created_at, b.updated_at`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanBooking reads a row selected with bookingColumns, followed by any extra columns
+func scanBooking(row scanner, b *domain.Booking, extra ...interface{}) error {
+	var notes, refundStatus, cancelledBy, cancellationReason sql.NullString
+	var cancelledAt sql.NullTime
+
+	dest := []interface{}{
+		&b.ID, &b.GroundID, &b.UserID, &b.BookingDate,
+		&b.StartTime, &b.EndTime, &b.DurationType,
+		&b.TotalPrice, &b.Status, &b.PaymentStatus, &notes,
+		&refundStatus, &cancelledBy, &cancelledAt, &cancellationReason,
+		&b.CreatedAt, &b.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+
+	b.Notes = notes.String
+	b.RefundStatus = refundStatus.String
+	b.CancelledBy = cancelledBy.String
+	b.CancellationReason = cancellationReason.String
+	if cancelledAt.Valid {
+		b.CancelledAt = &cancelledAt.Time
+	}
+
+	return nil
+}
+
+func (r *groundRepository) ListBookingsByOwner(ctx context.Context, ownerID, status string) ([]domain.OwnerBooking, error) {
+	query := `
+		SELECT ` + bookingColumns + `, g.name
+		FROM bookings b
+		JOIN grounds g ON g.id = b.ground_id
+		WHERE g.owner_id = $1 AND ($2 = '' OR b.status = $2)
+		ORDER BY b.booking_date DESC, b.start_time DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, ownerID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bookings: %w", err)
+	}
+	defer rows.Close()
+
+	var bookings []domain.OwnerBooking
+	for rows.Next() {
+		var b domain.OwnerBooking
+		if err := scanBooking(rows, &b.Booking, &b.GroundName); err != nil {
+			return nil, fmt.Errorf("failed to scan booking: %w", err)
+		}
+		bookings = append(bookings, b)
+	}
+
+	return bookings, rows.Err()
+}
+
+// UpdateBookingStatus saves the status, payment and cancellation details of a
+// booking, provided it is still in fromStatus
+func (r *groundRepository) UpdateBookingStatus(ctx context.Context, booking *domain.Booking, fromStatus string) error {
+	query := `
+		UPDATE bookings
+		SET status = $1, payment_status = $2, refund_status = NULLIF($3, ''),
+		    cancelled_by = NULLIF($4, '')::uuid, cancelled_at = $5,
+		    cancellation_reason = NULLIF($6, ''), updated_at = CURRENT_TIMESTAMP
+		WHERE id = $7 AND status = $8
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		booking.Status, booking.PaymentStatus, booking.RefundStatus,
+		booking.CancelledBy, booking.CancelledAt, booking.CancellationReason,
+		booking.ID, fromStatus,
+	).Scan(&booking.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("booking is no longer %s", fromStatus)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update booking: %w", err)
+	}
+
+	return nil
+}
+
+// SweepPastBookings completes confirmed bookings that have ended and cancels
+// pending ones that were never confirmed before they started. Booking times
+// are wall-clock times, so they are compared with now's wall clock rather than
+// the database session's.
+func (r *groundRepository) SweepPastBookings(ctx context.Context, now time.Time) (int64, int64, error) {
+	wallClock := now.Format("2006-01-02 15:04:05")
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE bookings
+		SET status = 'completed', updated_at = CURRENT_TIMESTAMP
+		WHERE status = 'confirmed' AND booking_date + end_time <= $1::timestamp
+	`, wallClock)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to complete bookings: %w", err)
+	}
+	completed, err := result.RowsAffected()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to complete bookings: %w", err)
+	}
+
+	result, err = r.db.ExecContext(ctx, `
+		UPDATE bookings
+		SET status = 'cancelled', refund_status = 'eligible',
+		    payment_status = CASE WHEN payment_status = 'paid' THEN 'refunded' ELSE payment_status END,
+		    cancelled_at = CURRENT_TIMESTAMP,
+		    cancellation_reason = 'Not confirmed by the ground owner before the booking started',
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE status = 'pending' AND booking_date + start_time <= $1::timestamp
+	`, wallClock)
+	if err != nil {
+		return completed, 0, fmt.Errorf("failed to expire bookings: %w", err)
+	}
+	expired, err := result.RowsAffected()
+	if err != nil {
+		return completed, 0, fmt.Errorf("failed to expire bookings: %w", err)
+	}
+
+	return completed, expired, nil
+}
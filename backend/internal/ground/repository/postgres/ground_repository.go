@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math"
+	"strings"
 
 	"github.com/cricketapp/backend/internal/ground/domain"
 	"github.com/lib/pq"
@@ -17,28 +19,151 @@ func NewGroundRepository(db *sql.DB) domain.GroundRepository {
 	return &groundRepository{db: db}
 }
 
-func (r *groundRepository) ListGrounds(ctx context.Context, page, limit int) ([]domain.Ground, int, error) {
-	offset := (page - 1) * limit
+// groundColumns selects a ground in the order read by scanGround
+const groundColumns = `
+	id, owner_id, name, description, address, latitude, longitude,
+	facilities, hourly_price, half_day_price, full_day_price, images,
+	to_char(opening_time, 'HH24:MI'), to_char(closing_time, 'HH24:MI'), slot_minutes,
+	rating, total_reviews, is_active, created_at, updated_at`
 
-	// Get total count
+// scanGround reads a row selected with groundColumns, followed by any extra columns
+func scanGround(row scanner, g *domain.Ground, extra ...interface{}) error {
+	var description, latitude, longitude sql.NullString
+	var facilities, images pq.StringArray
+
+	dest := []interface{}{
+		&g.ID, &g.OwnerID, &g.Name, &description, &g.Address,
+		&latitude, &longitude, &facilities,
+		&g.HourlyPrice, &g.HalfDayPrice, &g.FullDayPrice, &images,
+		&g.OpeningTime, &g.ClosingTime, &g.SlotMinutes,
+		&g.Rating, &g.TotalReviews, &g.IsActive, &g.CreatedAt, &g.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+
+	if description.Valid {
+		g.Description = description.String
+	}
+	if latitude.Valid && longitude.Valid {
+		fmt.Sscanf(latitude.String, "%f", &g.Latitude)
+		fmt.Sscanf(longitude.String, "%f", &g.Longitude)
+	}
+	g.Facilities = facilities
+	g.Images = images
+
+	return nil
+}
+
+// distanceExpr is the great circle distance in km from the point given by
+// the two placeholders to a ground
+const distanceExpr = `6371 * acos(LEAST(1, GREATEST(-1,
+	cos(radians($%[1]d)) * cos(radians(latitude)) * cos(radians(longitude) - radians($%[2]d)) +
+	sin(radians($%[1]d)) * sin(radians(latitude)))))`
+
+func (r *groundRepository) ListGrounds(ctx context.Context, filters domain.GroundFilters) ([]domain.Ground, int, error) {
+	// Build WHERE clause
+	conditions := []string{"is_active = true"}
+	var args []interface{}
+	argCount := 1
+
+	// The distance only filters when a radius is given; otherwise it is just
+	// selected by the page query, which binds the point after the filters
+	distance := "NULL::float8"
+	near := filters.Latitude != nil && filters.Longitude != nil
+	if near && filters.RadiusKm != nil {
+		distance = fmt.Sprintf(distanceExpr, argCount, argCount+1)
+		conditions = append(conditions, fmt.Sprintf("latitude IS NOT NULL AND %s <= $%d", distance, argCount+2))
+		args = append(args, *filters.Latitude, *filters.Longitude, *filters.RadiusKm)
+		argCount += 3
+	}
+
+	if filters.MinPrice != nil {
+		conditions = append(conditions, fmt.Sprintf("hourly_price >= $%d", argCount))
+		args = append(args, *filters.MinPrice)
+		argCount++
+	}
+
+	if filters.MaxPrice != nil {
+		conditions = append(conditions, fmt.Sprintf("hourly_price <= $%d", argCount))
+		args = append(args, *filters.MaxPrice)
+		argCount++
+	}
+
+	if len(filters.Facilities) > 0 {
+		conditions = append(conditions, fmt.Sprintf("facilities @> $%d", argCount))
+		args = append(args, pq.Array(filters.Facilities))
+		argCount++
+	}
+
+	if filters.MinRating != nil {
+		conditions = append(conditions, fmt.Sprintf("rating >= $%d", argCount))
+		args = append(args, *filters.MinRating)
+		argCount++
+	}
+
+	if filters.Date != nil {
+		conditions = append(conditions, fmt.Sprintf(`NOT EXISTS (
+			SELECT 1 FROM ground_blackout_dates bd
+			WHERE bd.ground_id = grounds.id AND bd.blackout_date = $%d
+		)`, argCount))
+		date := argCount
+		args = append(args, *filters.Date)
+		argCount++
+
+		if filters.StartTime != nil && filters.EndTime != nil {
+			conditions = append(conditions, fmt.Sprintf(`opening_time <= $%[1]d::time AND closing_time >= $%[2]d::time
+				AND NOT EXISTS (
+					SELECT 1 FROM bookings b
+					WHERE b.ground_id = grounds.id AND b.booking_date = $%[3]d
+					  AND b.status IN ('pending', 'confirmed')
+					  AND b.start_time < $%[2]d::time AND b.end_time > $%[1]d::time
+				)`, argCount, argCount+1, date))
+			args = append(args, *filters.StartTime, *filters.EndTime)
+			argCount += 2
+		}
+	}
+
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+
+	// Count total
 	var total int
-	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM grounds WHERE is_active = true").Scan(&total)
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM grounds "+whereClause, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count grounds: %w", err)
 	}
 
+	pageArgs := append([]interface{}{}, args...)
+	if near && filters.RadiusKm == nil {
+		distance = fmt.Sprintf(distanceExpr, argCount, argCount+1)
+		pageArgs = append(pageArgs, *filters.Latitude, *filters.Longitude)
+		argCount += 2
+	}
+
+	orderBy := "rating DESC, created_at DESC"
+	switch filters.SortBy {
+	case domain.SortByDistance:
+		orderBy = "distance_km ASC NULLS LAST, rating DESC"
+	case domain.SortByPrice:
+		orderBy = "hourly_price ASC, rating DESC"
+	case domain.SortByPriceDesc:
+		orderBy = "hourly_price DESC, rating DESC"
+	}
+
+	offset := (filters.Page - 1) * filters.Limit
+
 	// Get grounds
-	query := `
-		SELECT id, owner_id, name, description, address, latitude, longitude,
-		       facilities, hourly_price, half_day_price, full_day_price, images,
-		       rating, total_reviews, is_active, created_at, updated_at
+	query := fmt.Sprintf(`
+		SELECT %s, %s AS distance_km
 		FROM grounds
-		WHERE is_active = true
-		ORDER BY rating DESC, created_at DESC
-		LIMIT $1 OFFSET $2
-	`
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, groundColumns, distance, whereClause, orderBy, argCount, argCount+1)
+
+	pageArgs = append(pageArgs, filters.Limit, offset)
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, pageArgs...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to query grounds: %w", err)
 	}
@@ -47,29 +172,14 @@ func (r *groundRepository) ListGrounds(ctx context.Context, page, limit int) ([]
 	var grounds []domain.Ground
 	for rows.Next() {
 		var g domain.Ground
-		var description, latitude, longitude sql.NullString
-		var facilities, images pq.StringArray
-
-		err := rows.Scan(
-			&g.ID, &g.OwnerID, &g.Name, &description, &g.Address,
-			&latitude, &longitude, &facilities,
-			&g.HourlyPrice, &g.HalfDayPrice, &g.FullDayPrice, &images,
-			&g.Rating, &g.TotalReviews, &g.IsActive, &g.CreatedAt, &g.UpdatedAt,
-		)
-		if err != nil {
+		var distanceKm sql.NullFloat64
+		if err := scanGround(rows, &g, &distanceKm); err != nil {
 			return nil, 0, fmt.Errorf("failed to scan ground: %w", err)
 		}
-
-		if description.Valid {
-			g.Description = description.String
-		}
-		if latitude.Valid && longitude.Valid {
-			fmt.Sscanf(latitude.String, "%f", &g.Latitude)
-			fmt.Sscanf(longitude.String, "%f", &g.Longitude)
+		if distanceKm.Valid {
+			d := math.Round(distanceKm.Float64*100) / 100
+			g.DistanceKm = &d
 		}
-		g.Facilities = facilities
-		g.Images = images
-
 		grounds = append(grounds, g)
 	}
 
@@ -78,23 +188,13 @@ func (r *groundRepository) ListGrounds(ctx context.Context, page, limit int) ([]
 
 func (r *groundRepository) GetGroundByID(ctx context.Context, groundID string) (*domain.Ground, error) {
 	query := `
-		SELECT id, owner_id, name, description, address, latitude, longitude,
-		       facilities, hourly_price, half_day_price, full_day_price, images,
-		       rating, total_reviews, is_active, created_at, updated_at
+		SELECT ` + groundColumns + `
 		FROM grounds
 		WHERE id = $1
 	`
 
 	var g domain.Ground
-	var description, latitude, longitude sql.NullString
-	var facilities, images pq.StringArray
-
-	err := r.db.QueryRowContext(ctx, query, groundID).Scan(
-		&g.ID, &g.OwnerID, &g.Name, &description, &g.Address,
-		&latitude, &longitude, &facilities,
-		&g.HourlyPrice, &g.HalfDayPrice, &g.FullDayPrice, &images,
-		&g.Rating, &g.TotalReviews, &g.IsActive, &g.CreatedAt, &g.UpdatedAt,
-	)
+	err := scanGround(r.db.QueryRowContext(ctx, query, groundID), &g)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("ground not found")
@@ -103,20 +203,58 @@ func (r *groundRepository) GetGroundByID(ctx context.Context, groundID string) (
 		return nil, fmt.Errorf("failed to get ground: %w", err)
 	}
 
-	if description.Valid {
-		g.Description = description.String
-	}
-	if latitude.Valid && longitude.Valid {
-		fmt.Sscanf(latitude.String, "%f", &g.Latitude)
-		fmt.Sscanf(longitude.String, "%f", &g.Longitude)
-	}
-	g.Facilities = facilities
-	g.Images = images
-
 	return &g, nil
 }
 
+// CreateBooking inserts a booking after checking, under a lock on the ground,
+// that the date is not blacked out and no active booking overlaps the window
 func (r *groundRepository) CreateBooking(ctx context.Context, booking *domain.Booking) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Serialize bookings per ground so concurrent requests cannot both pass the overlap check
+	var groundID string
+	err = tx.QueryRowContext(ctx, "SELECT id FROM grounds WHERE id = $1 FOR UPDATE", booking.GroundID).Scan(&groundID)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("ground not found")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock ground: %w", err)
+	}
+
+	var blackedOut bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM ground_blackout_dates
+			WHERE ground_id = $1 AND blackout_date = $2
+		)
+	`, booking.GroundID, booking.BookingDate).Scan(&blackedOut)
+	if err != nil {
+		return fmt.Errorf("failed to check blackout dates: %w", err)
+	}
+	if blackedOut {
+		return fmt.Errorf("ground is not available on this date")
+	}
+
+	var overlapping bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM bookings
+			WHERE ground_id = $1 AND booking_date = $2
+			  AND status IN ('pending', 'confirmed')
+			  AND start_time < $4 AND end_time > $3
+		)
+	`, booking.GroundID, booking.BookingDate, booking.StartTime, booking.EndTime).Scan(&overlapping)
+	if err != nil {
+		return fmt.Errorf("failed to check existing bookings: %w", err)
+	}
+	if overlapping {
+		return fmt.Errorf("this time slot is already booked")
+	}
+
 	query := `
 		INSERT INTO bookings (ground_id, user_id, booking_date, start_time, end_time,
 		                     duration_type, total_price, status, payment_status, notes)
@@ -124,7 +262,7 @@ func (r *groundRepository) CreateBooking(ctx context.Context, booking *domain.Bo
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.db.QueryRowContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		booking.GroundID, booking.UserID, booking.BookingDate,
 		booking.StartTime, booking.EndTime, booking.DurationType,
 		booking.TotalPrice, booking.Status, booking.PaymentStatus, booking.Notes,
@@ -137,17 +275,19 @@ func (r *groundRepository) CreateBooking(ctx context.Context, booking *domain.Bo
 		return fmt.Errorf("failed to create booking: %w", err)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to create booking: %w", err)
+	}
+
 	return nil
 }
 
 func (r *groundRepository) GetBookingsByUser(ctx context.Context, userID string) ([]domain.Booking, error) {
 	query := `
-		SELECT id, ground_id, user_id, booking_date, start_time, end_time,
-		       duration_type, total_price, status, payment_status, notes,
-		       created_at, updated_at
-		FROM bookings
-		WHERE user_id = $1
-		ORDER BY booking_date DESC, start_time DESC
+		SELECT ` + bookingColumns + `
+		FROM bookings b
+		WHERE b.user_id = $1
+		ORDER BY b.booking_date DESC, b.start_time DESC
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, userID)
@@ -159,21 +299,9 @@ func (r *groundRepository) GetBookingsByUser(ctx context.Context, userID string)
 	var bookings []domain.Booking
 	for rows.Next() {
 		var b domain.Booking
-		var notes sql.NullString
-
-		err := rows.Scan(
-			&b.ID, &b.GroundID, &b.UserID, &b.BookingDate,
-			&b.StartTime, &b.EndTime, &b.DurationType,
-			&b.TotalPrice, &b.Status, &b.PaymentStatus, &notes,
-			&b.CreatedAt, &b.UpdatedAt,
-		)
-		if err != nil {
+		if err := scanBooking(rows, &b); err != nil {
 			return nil, fmt.Errorf("failed to scan booking: %w", err)
 		}
-
-		if notes.Valid {
-			b.Notes = notes.String
-		}
 		bookings = append(bookings, b)
 	}
 
@@ -182,22 +310,13 @@ func (r *groundRepository) GetBookingsByUser(ctx context.Context, userID string)
 
 func (r *groundRepository) GetBookingByID(ctx context.Context, bookingID string) (*domain.Booking, error) {
 	query := `
-		SELECT id, ground_id, user_id, booking_date, start_time, end_time,
-		       duration_type, total_price, status, payment_status, notes,
-		       created_at, updated_at
-		FROM bookings
-		WHERE id = $1
+		SELECT ` + bookingColumns + `
+		FROM bookings b
+		WHERE b.id = $1
 	`
 
 	var b domain.Booking
-	var notes sql.NullString
-
-	err := r.db.QueryRowContext(ctx, query, bookingID).Scan(
-		&b.ID, &b.GroundID, &b.UserID, &b.BookingDate,
-		&b.StartTime, &b.EndTime, &b.DurationType,
-		&b.TotalPrice, &b.Status, &b.PaymentStatus, &notes,
-		&b.CreatedAt, &b.UpdatedAt,
-	)
+	err := scanBooking(r.db.QueryRowContext(ctx, query, bookingID), &b)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("booking not found")
@@ -206,9 +325,5 @@ func (r *groundRepository) GetBookingByID(ctx context.Context, bookingID string)
 		return nil, fmt.Errorf("failed to get booking: %w", err)
 	}
 
-	if notes.Valid {
-		b.Notes = notes.String
-	}
-
 	return &b, nil
 }
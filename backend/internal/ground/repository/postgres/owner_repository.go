@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/ground/domain"
+	"github.com/lib/pq"
+)
+
+// nullableCoordinate stores a missing location as NULL rather than 0,0
+func nullableCoordinate(g *domain.Ground, v float64) interface{} {
+	if g.Latitude == 0 && g.Longitude == 0 {
+		return nil
+	}
+	return v
+}
+
+func (r *groundRepository) CreateGround(ctx context.Context, ground *domain.Ground) error {
+	query := `
+		INSERT INTO grounds (owner_id, name, description, address, latitude, longitude,
+		                     facilities, hourly_price, half_day_price, full_day_price, images,
+		                     opening_time, closing_time, slot_minutes, is_active)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING id, rating, total_reviews, created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		ground.OwnerID, ground.Name, ground.Description, ground.Address,
+		nullableCoordinate(ground, ground.Latitude), nullableCoordinate(ground, ground.Longitude),
+		pq.Array(ground.Facilities), ground.HourlyPrice, ground.HalfDayPrice, ground.FullDayPrice,
+		pq.Array(ground.Images), ground.OpeningTime, ground.ClosingTime, ground.SlotMinutes, ground.IsActive,
+	).Scan(&ground.ID, &ground.Rating, &ground.TotalReviews, &ground.CreatedAt, &ground.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to create ground: %w", err)
+	}
+
+	return nil
+}
+
+func (r *groundRepository) UpdateGround(ctx context.Context, ground *domain.Ground) error {
+	query := `
+		UPDATE grounds
+		SET name = $1, description = NULLIF($2, ''), address = $3, latitude = $4, longitude = $5,
+		    facilities = $6, hourly_price = $7, half_day_price = $8, full_day_price = $9,
+		    images = $10, opening_time = $11, closing_time = $12, slot_minutes = $13,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $14
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		ground.Name, ground.Description, ground.Address,
+		nullableCoordinate(ground, ground.Latitude), nullableCoordinate(ground, ground.Longitude),
+		pq.Array(ground.Facilities), ground.HourlyPrice, ground.HalfDayPrice, ground.FullDayPrice,
+		pq.Array(ground.Images), ground.OpeningTime, ground.ClosingTime, ground.SlotMinutes,
+		ground.ID,
+	).Scan(&ground.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("ground not found")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update ground: %w", err)
+	}
+
+	return nil
+}
+
+func (r *groundRepository) SetGroundActive(ctx context.Context, groundID string, active bool) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE grounds SET is_active = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2",
+		active, groundID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update ground: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update ground: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("ground not found")
+	}
+
+	return nil
+}
+
+func (r *groundRepository) UpdateGroundImages(ctx context.Context, groundID string, images []string) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE grounds SET images = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2",
+		pq.Array(images), groundID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update images: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update images: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("ground not found")
+	}
+
+	return nil
+}
+
+func (r *groundRepository) ListGroundsByOwner(ctx context.Context, ownerID string) ([]domain.Ground, error) {
+	query := `
+		SELECT ` + groundColumns + `
+		FROM grounds
+		WHERE owner_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query grounds: %w", err)
+	}
+	defer rows.Close()
+
+	var grounds []domain.Ground
+	for rows.Next() {
+		var g domain.Ground
+		if err := scanGround(rows, &g); err != nil {
+			return nil, fmt.Errorf("failed to scan ground: %w", err)
+		}
+		grounds = append(grounds, g)
+	}
+
+	return grounds, rows.Err()
+}
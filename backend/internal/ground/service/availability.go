@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cricketapp/backend/internal/ground/domain"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	timeLayout = "15:04"
+
+	// maxAvailabilityDays caps the range of a single availability request
+	maxAvailabilityDays = 31
+)
+
+func (s *groundService) GetAvailability(ctx context.Context, groundID, from, to string) (*domain.AvailabilityResponse, error) {
+	ground, err := s.groundRepo.GetGroundByID(ctx, groundID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+
+	start := today
+	if from != "" {
+		start, err = time.ParseInLocation(dateLayout, from, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date, expected YYYY-MM-DD")
+		}
+	}
+
+	end := start.AddDate(0, 0, 6)
+	if to != "" {
+		end, err = time.ParseInLocation(dateLayout, to, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date, expected YYYY-MM-DD")
+		}
+	}
+
+	if end.Before(start) {
+		return nil, fmt.Errorf("to date must not be before from date")
+	}
+	if end.Sub(start) >= maxAvailabilityDays*24*time.Hour {
+		return nil, fmt.Errorf("date range cannot exceed %d days", maxAvailabilityDays)
+	}
+
+	fromDate, toDate := start.Format(dateLayout), end.Format(dateLayout)
+
+	bookings, err := s.groundRepo.ListActiveBookings(ctx, groundID, fromDate, toDate)
+	if err != nil {
+		return nil, err
+	}
+
+	blackouts, err := s.groundRepo.ListBlackoutDates(ctx, groundID, fromDate, toDate)
+	if err != nil {
+		return nil, err
+	}
+
+	bookingsByDate := make(map[string][]domain.Booking)
+	for _, b := range bookings {
+		bookingsByDate[b.BookingDate] = append(bookingsByDate[b.BookingDate], b)
+	}
+
+	blackoutByDate := make(map[string]domain.BlackoutDate)
+	for _, b := range blackouts {
+		blackoutByDate[b.Date] = b
+	}
+
+	opening, _ := time.Parse(timeLayout, ground.OpeningTime)
+	closing, _ := time.Parse(timeLayout, ground.ClosingTime)
+	slotLength := time.Duration(ground.SlotMinutes) * time.Minute
+
+	response := &domain.AvailabilityResponse{
+		GroundID:    ground.ID,
+		From:        fromDate,
+		To:          toDate,
+		OpeningTime: ground.OpeningTime,
+		ClosingTime: ground.ClosingTime,
+		SlotMinutes: ground.SlotMinutes,
+		Days:        []domain.DayAvailability{},
+	}
+
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(dateLayout)
+		availability := domain.DayAvailability{Date: date, Slots: []domain.Slot{}}
+
+		if blackout, ok := blackoutByDate[date]; ok {
+			availability.IsBlackout = true
+			availability.BlackoutReason = blackout.Reason
+			response.Days = append(response.Days, availability)
+			continue
+		}
+
+		for slotStart := opening; slotLength > 0 && !slotStart.Add(slotLength).After(closing); slotStart = slotStart.Add(slotLength) {
+			slot := domain.Slot{
+				StartTime: slotStart.Format(timeLayout),
+				EndTime:   slotStart.Add(slotLength).Format(timeLayout),
+				Status:    "free",
+			}
+
+			startsAt := time.Date(day.Year(), day.Month(), day.Day(), slotStart.Hour(), slotStart.Minute(), 0, 0, time.Local)
+			if startsAt.Before(now) {
+				slot.Status = "past"
+			} else {
+				// Times are zero padded HH:MM so they compare correctly as strings
+				for _, b := range bookingsByDate[date] {
+					if b.StartTime < slot.EndTime && b.EndTime > slot.StartTime {
+						slot.Status = "booked"
+						break
+					}
+				}
+			}
+
+			availability.Slots = append(availability.Slots, slot)
+		}
+
+		response.Days = append(response.Days, availability)
+	}
+
+	return response, nil
+}
+
+func (s *groundService) UpdateAvailability(ctx context.Context, userID, groundID string, req *domain.UpdateAvailabilityRequest) (*domain.Ground, error) {
+	if _, err := s.ownedGround(ctx, userID, groundID); err != nil {
+		return nil, err
+	}
+
+	if err := validateHours(req.OpeningTime, req.ClosingTime, req.SlotMinutes); err != nil {
+		return nil, err
+	}
+
+	if err := s.groundRepo.UpdateAvailability(ctx, groundID, req); err != nil {
+		return nil, err
+	}
+
+	return s.groundRepo.GetGroundByID(ctx, groundID)
+}
+
+func (s *groundService) AddBlackoutDate(ctx context.Context, userID, groundID string, req *domain.AddBlackoutRequest) (*domain.BlackoutDate, error) {
+	if _, err := s.ownedGround(ctx, userID, groundID); err != nil {
+		return nil, err
+	}
+
+	if _, err := time.Parse(dateLayout, req.Date); err != nil {
+		return nil, fmt.Errorf("invalid date, expected YYYY-MM-DD")
+	}
+
+	blackout := &domain.BlackoutDate{
+		GroundID: groundID,
+		Date:     req.Date,
+		Reason:   req.Reason,
+	}
+
+	if err := s.groundRepo.AddBlackoutDate(ctx, blackout); err != nil {
+		return nil, err
+	}
+
+	return blackout, nil
+}
+
+func (s *groundService) DeleteBlackoutDate(ctx context.Context, userID, groundID, blackoutID string) error {
+	if _, err := s.ownedGround(ctx, userID, groundID); err != nil {
+		return err
+	}
+
+	return s.groundRepo.DeleteBlackoutDate(ctx, groundID, blackoutID)
+}
+
+// ownedGround loads a ground and checks that userID owns it
+func (s *groundService) ownedGround(ctx context.Context, userID, groundID string) (*domain.Ground, error) {
+	ground, err := s.groundRepo.GetGroundByID(ctx, groundID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ground.OwnerID != userID {
+		return nil, fmt.Errorf("unauthorized: only the ground owner can manage this ground")
+	}
+
+	return ground, nil
+}
+
+// validateBookingWindow checks that a booking falls on whole slots within the
+// ground's opening hours and has not already started
+func (s *groundService) validateBookingWindow(ground *domain.Ground, req *domain.CreateBookingRequest) error {
+	date, err := time.ParseInLocation(dateLayout, req.BookingDate, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid booking date, expected YYYY-MM-DD")
+	}
+	start, err := time.Parse(timeLayout, req.StartTime)
+	if err != nil {
+		return fmt.Errorf("invalid start time, expected HH:MM")
+	}
+	end, err := time.Parse(timeLayout, req.EndTime)
+	if err != nil {
+		return fmt.Errorf("invalid end time, expected HH:MM")
+	}
+
+	if !end.After(start) {
+		return fmt.Errorf("end time must be after start time")
+	}
+
+	opening, _ := time.Parse(timeLayout, ground.OpeningTime)
+	closing, _ := time.Parse(timeLayout, ground.ClosingTime)
+	if start.Before(opening) || end.After(closing) {
+		return fmt.Errorf("booking must be within opening hours %s - %s", ground.OpeningTime, ground.ClosingTime)
+	}
+
+	if ground.SlotMinutes > 0 {
+		slot := time.Duration(ground.SlotMinutes) * time.Minute
+		if start.Sub(opening)%slot != 0 || end.Sub(opening)%slot != 0 {
+			return fmt.Errorf("booking must start and end on %d minute slots", ground.SlotMinutes)
+		}
+	}
+
+	startsAt := time.Date(date.Year(), date.Month(), date.Day(), start.Hour(), start.Minute(), 0, 0, time.Local)
+	if startsAt.Before(time.Now()) {
+		return fmt.Errorf("cannot book a time in the past")
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cricketapp/backend/internal/ground/domain"
+)
+
+func (s *groundService) ListOwnerBookings(ctx context.Context, ownerID, status string) ([]domain.OwnerBooking, error) {
+	if ownerID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	return s.groundRepo.ListBookingsByOwner(ctx, ownerID, status)
+}
+
+func (s *groundService) ConfirmBooking(ctx context.Context, ownerID, bookingID string) (*domain.Booking, error) {
+	booking, err := s.ownerBooking(ctx, ownerID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	startsAt, err := bookingStart(booking)
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(startsAt) {
+		return nil, fmt.Errorf("cannot confirm a booking that has already started")
+	}
+
+	if err := s.transition(ctx, booking, domain.BookingConfirmed); err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func (s *groundService) RejectBooking(ctx context.Context, ownerID, bookingID string, req *domain.BookingActionRequest) (*domain.Booking, error) {
+	booking, err := s.ownerBooking(ctx, ownerID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A rejected booking is always refunded in full
+	cancel(booking, ownerID, req.Reason, domain.RefundEligible)
+	if err := s.transition(ctx, booking, domain.BookingRejected); err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func (s *groundService) CancelBooking(ctx context.Context, userID, bookingID string, req *domain.BookingActionRequest) (*domain.Booking, error) {
+	booking, err := s.groundRepo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if booking.UserID != userID {
+		return nil, fmt.Errorf("unauthorized: only the user who made the booking can cancel it")
+	}
+
+	startsAt, err := bookingStart(booking)
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(startsAt) {
+		return nil, fmt.Errorf("cannot cancel a booking that has already started")
+	}
+
+	refund := domain.RefundNotEligible
+	if time.Until(startsAt) >= s.cancellationWindow {
+		refund = domain.RefundEligible
+	}
+
+	cancel(booking, userID, req.Reason, refund)
+	if err := s.transition(ctx, booking, domain.BookingCancelled); err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func (s *groundService) SweepPastBookings(ctx context.Context) error {
+	completed, expired, err := s.groundRepo.SweepPastBookings(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if completed > 0 || expired > 0 {
+		log.Printf("Booking sweep: %d completed, %d expired", completed, expired)
+	}
+
+	return nil
+}
+
+// RunBookingSweeper completes past bookings every interval until ctx is done
+func RunBookingSweeper(ctx context.Context, svc domain.GroundService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := svc.SweepPastBookings(ctx); err != nil {
+			log.Printf("Booking sweep failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ownerBooking loads a booking and checks that ownerID owns its ground
+func (s *groundService) ownerBooking(ctx context.Context, ownerID, bookingID string) (*domain.Booking, error) {
+	booking, err := s.groundRepo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	ground, err := s.groundRepo.GetGroundByID(ctx, booking.GroundID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ground.OwnerID != ownerID {
+		return nil, fmt.Errorf("unauthorized: only the ground owner can manage this booking")
+	}
+
+	return booking, nil
+}
+
+// transition moves a booking to a new status if the move is allowed
+func (s *groundService) transition(ctx context.Context, booking *domain.Booking, to string) error {
+	from := booking.Status
+	if !domain.CanTransition(from, to) {
+		return fmt.Errorf("cannot change booking from %s to %s", from, to)
+	}
+
+	booking.Status = to
+	return s.groundRepo.UpdateBookingStatus(ctx, booking, from)
+}
+
+// cancel records who called off a booking and whether it will be refunded
+func cancel(booking *domain.Booking, userID, reason, refund string) {
+	now := time.Now()
+	booking.CancelledBy = userID
+	booking.CancelledAt = &now
+	booking.CancellationReason = reason
+	booking.RefundStatus = refund
+	if refund == domain.RefundEligible && booking.PaymentStatus == "paid" {
+		booking.PaymentStatus = "refunded"
+	}
+}
+
+func bookingStart(booking *domain.Booking) (time.Time, error) {
+	startsAt, err := time.ParseInLocation(dateLayout+" "+timeLayout, booking.BookingDate+" "+booking.StartTime, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid booking date or time")
+	}
+	return startsAt, nil
+}
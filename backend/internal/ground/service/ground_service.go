@@ -4,40 +4,54 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/cricketapp/backend/internal/ground/domain"
 )
 
 type groundService struct {
-	groundRepo domain.GroundRepository
+	groundRepo         domain.GroundRepository
+	images             domain.ImageStore
+	cancellationWindow time.Duration
 }
 
-func NewGroundService(groundRepo domain.GroundRepository) domain.GroundService {
+// halfDayHours is the length of a half day booking
+const halfDayHours = 6
+
+// NewGroundService creates a ground service. Bookings cancelled at least
+// cancellationWindow before they start are eligible for a refund.
+func NewGroundService(groundRepo domain.GroundRepository, images domain.ImageStore, cancellationWindow time.Duration) domain.GroundService {
 	return &groundService{
-		groundRepo: groundRepo,
+		groundRepo:         groundRepo,
+		images:             images,
+		cancellationWindow: cancellationWindow,
 	}
 }
 
-func (s *groundService) ListGrounds(ctx context.Context, page, limit int) (*domain.GroundListResponse, error) {
-	if page < 1 {
-		page = 1
+func (s *groundService) ListGrounds(ctx context.Context, filters domain.GroundFilters) (*domain.GroundListResponse, error) {
+	if filters.Page < 1 {
+		filters.Page = 1
 	}
-	if limit < 1 || limit > 100 {
-		limit = 10
+	if filters.Limit < 1 || filters.Limit > 100 {
+		filters.Limit = 10
 	}
 
-	grounds, total, err := s.groundRepo.ListGrounds(ctx, page, limit)
+	if err := validateGroundFilters(&filters); err != nil {
+		return nil, err
+	}
+
+	grounds, total, err := s.groundRepo.ListGrounds(ctx, filters)
 	if err != nil {
 		return nil, err
 	}
 
-	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+	totalPages := int(math.Ceil(float64(total) / float64(filters.Limit)))
 
 	return &domain.GroundListResponse{
 		Grounds: grounds,
 		Pagination: domain.Pagination{
-			Page:       page,
-			Limit:      limit,
+			Page:       filters.Page,
+			Limit:      filters.Limit,
 			Total:      total,
 			TotalPages: totalPages,
 		},
@@ -69,6 +83,14 @@ func (s *groundService) CreateBooking(ctx context.Context, userID string, req *d
 		return nil, fmt.Errorf("invalid ground ID: %w", err)
 	}
 
+	if !ground.IsActive {
+		return nil, fmt.Errorf("ground is not accepting bookings")
+	}
+
+	if err := s.validateBookingWindow(ground, req); err != nil {
+		return nil, err
+	}
+
 	// Calculate price based on duration type
 	var price float64
 	switch req.DurationType {
@@ -91,7 +113,7 @@ func (s *groundService) CreateBooking(ctx context.Context, userID string, req *d
 		EndTime:       req.EndTime,
 		DurationType:  req.DurationType,
 		TotalPrice:    price,
-		Status:        "pending",
+		Status:        domain.BookingPending,
 		PaymentStatus: "pending",
 		Notes:         req.Notes,
 	}
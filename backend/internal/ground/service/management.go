@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cricketapp/backend/internal/ground/domain"
+)
+
+const (
+	groundOwnerRole = "ground_owner"
+
+	// maxGroundImages limits how many images a single ground can show
+	maxGroundImages = 10
+)
+
+func (s *groundService) ListMyGrounds(ctx context.Context, ownerID string) ([]domain.Ground, error) {
+	if ownerID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	return s.groundRepo.ListGroundsByOwner(ctx, ownerID)
+}
+
+func (s *groundService) CreateGround(ctx context.Context, ownerID, role string, req *domain.CreateGroundRequest) (*domain.Ground, error) {
+	if role != groundOwnerRole {
+		return nil, fmt.Errorf("unauthorized: only ground owners can list grounds")
+	}
+
+	ground := &domain.Ground{
+		OwnerID:      ownerID,
+		Name:         strings.TrimSpace(req.Name),
+		Description:  strings.TrimSpace(req.Description),
+		Address:      strings.TrimSpace(req.Address),
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+		Facilities:   req.Facilities,
+		HourlyPrice:  req.HourlyPrice,
+		HalfDayPrice: req.HalfDayPrice,
+		FullDayPrice: req.FullDayPrice,
+		Images:       req.Images,
+		OpeningTime:  req.OpeningTime,
+		ClosingTime:  req.ClosingTime,
+		SlotMinutes:  req.SlotMinutes,
+		IsActive:     true,
+	}
+
+	if ground.OpeningTime == "" {
+		ground.OpeningTime = "06:00"
+	}
+	if ground.ClosingTime == "" {
+		ground.ClosingTime = "22:00"
+	}
+	if ground.SlotMinutes == 0 {
+		ground.SlotMinutes = 60
+	}
+
+	if err := validateGround(ground); err != nil {
+		return nil, err
+	}
+	// The ground has no ID yet, so no uploaded image can belong to it
+	if err := s.checkImages("", ground.Images); err != nil {
+		return nil, err
+	}
+
+	if err := s.groundRepo.CreateGround(ctx, ground); err != nil {
+		return nil, err
+	}
+
+	return ground, nil
+}
+
+func (s *groundService) UpdateGround(ctx context.Context, ownerID, groundID string, req *domain.UpdateGroundRequest) (*domain.Ground, error) {
+	ground, err := s.ownedGround(ctx, ownerID, groundID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		ground.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		ground.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.Address != nil {
+		ground.Address = strings.TrimSpace(*req.Address)
+	}
+	if req.Latitude != nil {
+		ground.Latitude = *req.Latitude
+	}
+	if req.Longitude != nil {
+		ground.Longitude = *req.Longitude
+	}
+	if req.Facilities != nil {
+		ground.Facilities = *req.Facilities
+	}
+	if req.HourlyPrice != nil {
+		ground.HourlyPrice = *req.HourlyPrice
+	}
+	if req.HalfDayPrice != nil {
+		ground.HalfDayPrice = *req.HalfDayPrice
+	}
+	if req.FullDayPrice != nil {
+		ground.FullDayPrice = *req.FullDayPrice
+	}
+	if req.Images != nil {
+		ground.Images = *req.Images
+	}
+	if req.OpeningTime != nil {
+		ground.OpeningTime = *req.OpeningTime
+	}
+	if req.ClosingTime != nil {
+		ground.ClosingTime = *req.ClosingTime
+	}
+	if req.SlotMinutes != nil {
+		ground.SlotMinutes = *req.SlotMinutes
+	}
+
+	if err := validateGround(ground); err != nil {
+		return nil, err
+	}
+	if err := s.checkImages(groundID, ground.Images); err != nil {
+		return nil, err
+	}
+
+	if err := s.groundRepo.UpdateGround(ctx, ground); err != nil {
+		return nil, err
+	}
+
+	return ground, nil
+}
+
+// SetGroundActive lists or delists a ground. A deactivated ground is hidden
+// from search and takes no new bookings; existing bookings are kept.
+func (s *groundService) SetGroundActive(ctx context.Context, ownerID, groundID string, active bool) (*domain.Ground, error) {
+	ground, err := s.ownedGround(ctx, ownerID, groundID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.groundRepo.SetGroundActive(ctx, groundID, active); err != nil {
+		return nil, err
+	}
+
+	ground.IsActive = active
+	return ground, nil
+}
+
+func (s *groundService) UploadGroundImage(ctx context.Context, ownerID, groundID, contentType string, data io.Reader) (*domain.Ground, error) {
+	ground, err := s.ownedGround(ctx, ownerID, groundID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ground.Images) >= maxGroundImages {
+		return nil, fmt.Errorf("a ground can have at most %d images", maxGroundImages)
+	}
+
+	imageURL, err := s.images.Save(ctx, groundID, contentType, data)
+	if err != nil {
+		return nil, err
+	}
+
+	ground.Images = append(ground.Images, imageURL)
+	if err := s.groundRepo.UpdateGroundImages(ctx, groundID, ground.Images); err != nil {
+		s.images.Delete(ctx, groundID, imageURL)
+		return nil, err
+	}
+
+	return ground, nil
+}
+
+func (s *groundService) DeleteGroundImage(ctx context.Context, ownerID, groundID, imageURL string) (*domain.Ground, error) {
+	ground, err := s.ownedGround(ctx, ownerID, groundID)
+	if err != nil {
+		return nil, err
+	}
+
+	images := make([]string, 0, len(ground.Images))
+	for _, image := range ground.Images {
+		if image != imageURL {
+			images = append(images, image)
+		}
+	}
+	if len(images) == len(ground.Images) {
+		return nil, fmt.Errorf("image not found")
+	}
+
+	if err := s.groundRepo.UpdateGroundImages(ctx, groundID, images); err != nil {
+		return nil, err
+	}
+
+	// Another ground's upload listed before images were checked is only
+	// removed from this ground, the file stays with its own ground
+	if s.images.Check(groundID, imageURL) == nil {
+		if err := s.images.Delete(ctx, groundID, imageURL); err != nil {
+			return nil, err
+		}
+	}
+
+	ground.Images = images
+	return ground, nil
+}
+
+// validateGround checks a ground's details before it is saved
+func validateGround(g *domain.Ground) error {
+	if g.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(g.Name) > 255 {
+		return fmt.Errorf("name must be at most 255 characters")
+	}
+	if g.Address == "" {
+		return fmt.Errorf("address is required")
+	}
+
+	// 0,0 is treated as no location
+	if g.Latitude < -90 || g.Latitude > 90 {
+		return fmt.Errorf("latitude must be between -90 and 90")
+	}
+	if g.Longitude < -180 || g.Longitude > 180 {
+		return fmt.Errorf("longitude must be between -180 and 180")
+	}
+
+	// Longer bookings must not cost more per hour than shorter ones
+	if g.HourlyPrice <= 0 {
+		return fmt.Errorf("hourly price must be greater than zero")
+	}
+	if g.HalfDayPrice < g.HourlyPrice {
+		return fmt.Errorf("half day price cannot be less than the hourly price")
+	}
+	if g.FullDayPrice < g.HalfDayPrice {
+		return fmt.Errorf("full day price cannot be less than the half day price")
+	}
+	if g.HalfDayPrice > g.HourlyPrice*halfDayHours {
+		return fmt.Errorf("half day price cannot exceed %d hours at the hourly price", halfDayHours)
+	}
+	if g.FullDayPrice > g.HalfDayPrice*2 {
+		return fmt.Errorf("full day price cannot exceed two half days")
+	}
+
+	seen := make(map[string]bool, len(g.Facilities))
+	for _, facility := range g.Facilities {
+		if !domain.ValidFacilities[facility] {
+			return fmt.Errorf("invalid facility: %s", facility)
+		}
+		if seen[facility] {
+			return fmt.Errorf("duplicate facility: %s", facility)
+		}
+		seen[facility] = true
+	}
+
+	if len(g.Images) > maxGroundImages {
+		return fmt.Errorf("a ground can have at most %d images", maxGroundImages)
+	}
+	for _, image := range g.Images {
+		if !validImageURL(image) {
+			return fmt.Errorf("invalid image URL: %s", image)
+		}
+	}
+
+	return validateHours(g.OpeningTime, g.ClosingTime, g.SlotMinutes)
+}
+
+// checkImages rejects images uploaded for a different ground
+func (s *groundService) checkImages(groundID string, images []string) error {
+	for _, image := range images {
+		if err := s.images.Check(groundID, image); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateHours checks that the opening hours fit at least one whole slot
+func validateHours(openingTime, closingTime string, slotMinutes int) error {
+	opening, err := time.Parse(timeLayout, openingTime)
+	if err != nil {
+		return fmt.Errorf("invalid opening time, expected HH:MM")
+	}
+	closing, err := time.Parse(timeLayout, closingTime)
+	if err != nil {
+		return fmt.Errorf("invalid closing time, expected HH:MM")
+	}
+	if !closing.After(opening) {
+		return fmt.Errorf("closing time must be after opening time")
+	}
+	if slotMinutes < 15 || slotMinutes > 24*60 {
+		return fmt.Errorf("slot minutes must be between 15 and 1440")
+	}
+	if closing.Sub(opening) < time.Duration(slotMinutes)*time.Minute {
+		return fmt.Errorf("opening hours must fit at least one slot")
+	}
+	return nil
+}
+
+// validImageURL accepts absolute http(s) URLs and paths served by this API
+func validImageURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" {
+		return strings.HasPrefix(u.Path, "/") && u.Host == ""
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
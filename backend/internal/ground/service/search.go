@@ -0,0 +1,108 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cricketapp/backend/internal/ground/domain"
+)
+
+// maxSearchRadiusKm caps how far a nearby search can reach
+const maxSearchRadiusKm = 500
+
+// validateGroundFilters checks the search filters and fills in defaults
+func validateGroundFilters(f *domain.GroundFilters) error {
+	near := f.Latitude != nil && f.Longitude != nil
+	if (f.Latitude == nil) != (f.Longitude == nil) {
+		return fmt.Errorf("both latitude and longitude are required")
+	}
+	if near {
+		if *f.Latitude < -90 || *f.Latitude > 90 || *f.Longitude < -180 || *f.Longitude > 180 {
+			return fmt.Errorf("invalid coordinates")
+		}
+	}
+
+	if f.RadiusKm != nil {
+		if !near {
+			return fmt.Errorf("radius requires a location")
+		}
+		if *f.RadiusKm <= 0 || *f.RadiusKm > maxSearchRadiusKm {
+			return fmt.Errorf("radius must be between 0 and %d km", maxSearchRadiusKm)
+		}
+	}
+
+	if f.MinPrice != nil && *f.MinPrice < 0 {
+		return fmt.Errorf("minimum price cannot be negative")
+	}
+	if f.MinPrice != nil && f.MaxPrice != nil && *f.MaxPrice < *f.MinPrice {
+		return fmt.Errorf("maximum price cannot be less than minimum price")
+	}
+
+	for _, facility := range f.Facilities {
+		if !domain.ValidFacilities[facility] {
+			return fmt.Errorf("invalid facility: %s", facility)
+		}
+	}
+
+	if f.MinRating != nil && (*f.MinRating < 0 || *f.MinRating > 5) {
+		return fmt.Errorf("minimum rating must be between 0 and 5")
+	}
+
+	if err := validateAvailabilityFilter(f); err != nil {
+		return err
+	}
+
+	switch f.SortBy {
+	case "":
+		f.SortBy = domain.SortByRating
+		if near {
+			f.SortBy = domain.SortByDistance
+		}
+	case domain.SortByRating, domain.SortByPrice, domain.SortByPriceDesc:
+	case domain.SortByDistance:
+		if !near {
+			return fmt.Errorf("sorting by distance requires a location")
+		}
+	default:
+		return fmt.Errorf("invalid sort: %s", f.SortBy)
+	}
+
+	return nil
+}
+
+func validateAvailabilityFilter(f *domain.GroundFilters) error {
+	if (f.StartTime == nil) != (f.EndTime == nil) {
+		return fmt.Errorf("both start time and end time are required")
+	}
+	if f.StartTime != nil && f.Date == nil {
+		return fmt.Errorf("a date is required to search by time")
+	}
+	if f.Date == nil {
+		return nil
+	}
+
+	date, err := time.ParseInLocation(dateLayout, *f.Date, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid date, expected YYYY-MM-DD")
+	}
+	now := time.Now()
+	if date.Before(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)) {
+		return fmt.Errorf("date cannot be in the past")
+	}
+
+	if f.StartTime != nil {
+		start, err := time.Parse(timeLayout, *f.StartTime)
+		if err != nil {
+			return fmt.Errorf("invalid start time, expected HH:MM")
+		}
+		end, err := time.Parse(timeLayout, *f.EndTime)
+		if err != nil {
+			return fmt.Errorf("invalid end time, expected HH:MM")
+		}
+		if !end.After(start) {
+			return fmt.Errorf("end time must be after start time")
+		}
+	}
+
+	return nil
+}
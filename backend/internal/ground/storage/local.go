@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cricketapp/backend/internal/ground/domain"
+	"github.com/google/uuid"
+)
+
+// imageExtensions maps the accepted image types to the extension they are saved with
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+type localImageStore struct {
+	dir     string
+	baseURL string
+}
+
+// NewLocalImageStore saves images under dir and serves them from baseURL,
+// which must be where dir is exposed by the HTTP server
+func NewLocalImageStore(dir, baseURL string) domain.ImageStore {
+	return &localImageStore{
+		dir:     dir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (s *localImageStore) Save(ctx context.Context, groundID, contentType string, data io.Reader) (string, error) {
+	ext, ok := imageExtensions[contentType]
+	if !ok {
+		return "", fmt.Errorf("unsupported image type %s, use JPEG, PNG or WebP", contentType)
+	}
+
+	if _, err := uuid.Parse(groundID); err != nil {
+		return "", fmt.Errorf("invalid ground ID")
+	}
+
+	dir := filepath.Join(s.dir, "grounds", groundID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create image directory: %w", err)
+	}
+
+	name := uuid.New().String() + ext
+	file, err := os.Create(filepath.Join(dir, name))
+	if err != nil {
+		return "", fmt.Errorf("failed to save image: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, data); err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("failed to save image: %w", err)
+	}
+
+	return fmt.Sprintf("%s/grounds/%s/%s", s.baseURL, groundID, name), nil
+}
+
+// Check rejects URLs this store serves that are not images of the ground, so
+// one ground cannot list, and later delete, another ground's uploads
+func (s *localImageStore) Check(groundID, url string) error {
+	_, err := s.localPath(groundID, url)
+	return err
+}
+
+// Delete removes an image the store saved for the ground. URLs of images
+// hosted elsewhere are ignored.
+func (s *localImageStore) Delete(ctx context.Context, groundID, url string) error {
+	path, err := s.localPath(groundID, url)
+	if err != nil || path == "" {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete image: %w", err)
+	}
+
+	return nil
+}
+
+// localPath returns the file behind an image URL served by this store, or
+// an empty path for images hosted elsewhere. The file must be in the
+// ground's own image directory.
+func (s *localImageStore) localPath(groundID, url string) (string, error) {
+	prefix := s.baseURL + "/"
+	if !strings.HasPrefix(url, prefix) {
+		return "", nil
+	}
+
+	rel := filepath.Clean(filepath.FromSlash(strings.TrimPrefix(url, prefix)))
+	if _, err := uuid.Parse(groundID); err != nil || filepath.Dir(rel) != filepath.Join("grounds", groundID) {
+		return "", fmt.Errorf("image %s does not belong to this ground", url)
+	}
+
+	return filepath.Join(s.dir, rel), nil
+}
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// bucket holds the tokens left for one client
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is an in-memory token bucket per client IP address
+type rateLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	capacity  float64
+	perSecond float64
+	lastSweep time.Time
+}
+
+// RateLimit allows each client IP address up to limit requests per window,
+// refilled continuously, and answers 429 once they are used up. Routes
+// sharing one RateLimit middleware share the same budget.
+func RateLimit(limit int, window time.Duration) func(http.Handler) http.Handler {
+	rl := &rateLimiter{
+		buckets:   make(map[string]*bucket),
+		capacity:  float64(limit),
+		perSecond: float64(limit) / window.Seconds(),
+		lastSweep: time.Now(),
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if wait, ok := rl.take(ClientIP(r)); !ok {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+				respondError(w, http.StatusTooManyRequests, "Too many requests, please slow down")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// take uses up one token for key, or reports how long until one is available
+func (rl *rateLimiter) take(key string) (time.Duration, bool) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	rl.sweep(now)
+
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &bucket{tokens: rl.capacity, last: now}
+		rl.buckets[key] = b
+	}
+
+	b.tokens = math.Min(rl.capacity, b.tokens+now.Sub(b.last).Seconds()*rl.perSecond)
+	b.last = now
+
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / rl.perSecond * float64(time.Second)), false
+	}
+
+	b.tokens--
+	return 0, true
+}
+
+// sweep drops buckets that have refilled completely, at most once a minute
+func (rl *rateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < time.Minute {
+		return
+	}
+	rl.lastSweep = now
+
+	for key, b := range rl.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*rl.perSecond >= rl.capacity {
+			delete(rl.buckets, key)
+		}
+	}
+}
+
+// ClientIP returns the IP address of the client, without the port
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
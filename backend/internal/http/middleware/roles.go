@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"net/http"
+
+	authdomain "github.com/cricketapp/backend/internal/auth/domain"
+)
+
+// RequireRole only lets through users with one of the given roles. Admins are
+// always allowed. It must run after AuthMiddleware.
+func RequireRole(roles ...string) func(http.Handler) http.Handler {
+	allowed := map[string]bool{authdomain.RoleAdmin: true}
+	for _, role := range roles {
+		allowed[role] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role, _ := r.Context().Value("user_role").(string)
+			if !allowed[authdomain.NormalizeRole(role)] {
+				respondError(w, http.StatusForbidden, "You do not have permission to perform this action")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -1,27 +1,42 @@
 package server
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"net/http"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/cricketapp/backend/config"
 	authhttp "github.com/cricketapp/backend/internal/auth/delivery/http"
+	authdomain "github.com/cricketapp/backend/internal/auth/domain"
 	communityhttp "github.com/cricketapp/backend/internal/community/delivery/http"
 	communityrepo "github.com/cricketapp/backend/internal/community/repository/postgres"
 	communityservice "github.com/cricketapp/backend/internal/community/service"
 	groundhttp "github.com/cricketapp/backend/internal/ground/delivery/http"
+	grounddomain "github.com/cricketapp/backend/internal/ground/domain"
+	groundrepo "github.com/cricketapp/backend/internal/ground/repository/postgres"
+	groundservice "github.com/cricketapp/backend/internal/ground/service"
+	groundstorage "github.com/cricketapp/backend/internal/ground/storage"
 	hiringhttp "github.com/cricketapp/backend/internal/hiring/delivery/http"
 	hiringrepo "github.com/cricketapp/backend/internal/hiring/repository/postgres"
 	hiringservice "github.com/cricketapp/backend/internal/hiring/service"
 	"github.com/cricketapp/backend/internal/http/middleware"
 	matchhttp "github.com/cricketapp/backend/internal/match/delivery/http"
+	matchdomain "github.com/cricketapp/backend/internal/match/domain"
+	matchlive "github.com/cricketapp/backend/internal/match/live"
 	matchrepo "github.com/cricketapp/backend/internal/match/repository/postgres"
 	matchservice "github.com/cricketapp/backend/internal/match/service"
 	medicalhttp "github.com/cricketapp/backend/internal/medical/delivery/http"
 	medicalrepo "github.com/cricketapp/backend/internal/medical/repository/postgres"
 	medicalservice "github.com/cricketapp/backend/internal/medical/service"
+	reviewhttp "github.com/cricketapp/backend/internal/review/delivery/http"
+	reviewrepo "github.com/cricketapp/backend/internal/review/repository/postgres"
+	reviewservice "github.com/cricketapp/backend/internal/review/service"
 	statisticshttp "github.com/cricketapp/backend/internal/statistics/delivery/http"
+	statisticsdomain "github.com/cricketapp/backend/internal/statistics/domain"
 	statisticsrepo "github.com/cricketapp/backend/internal/statistics/repository/postgres"
 	statisticsservice "github.com/cricketapp/backend/internal/statistics/service"
 	tournamenthttp "github.com/cricketapp/backend/internal/tournament/delivery/http"
@@ -31,11 +46,13 @@ import (
 	"github.com/go-chi/chi/v5"
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
+	"github.com/google/uuid"
 )
 
 type Server struct {
 	config            *config.Config
 	db                *sql.DB
+	groundSvc         grounddomain.GroundService
 	authHandler       *authhttp.AuthHandler
 	userHandler       *userhttp.UserHandler
 	groundHandler     *groundhttp.GroundHandler
@@ -45,12 +62,18 @@ type Server struct {
 	matchHandler      *matchhttp.MatchHandler
 	tournamentHandler *tournamenthttp.TournamentHandler
 	statisticsHandler *statisticshttp.StatisticsHandler
+	reviewHandler     *reviewhttp.ReviewHandler
 }
 
 func New(cfg *config.Config, db *sql.DB) *Server {
+	// Initialize ground service layers
+	groundRepo := groundrepo.NewGroundRepository(db)
+	groundImages := groundstorage.NewLocalImageStore(cfg.Storage.UploadDir, cfg.Storage.UploadURL)
+	groundSvc := groundservice.NewGroundService(groundRepo, groundImages, cfg.Booking.CancellationWindow)
+
 	// Initialize medical service layers
 	medicalRepo := medicalrepo.NewMedicalRepository(db)
-	medicalSvc := medicalservice.NewMedicalService(medicalRepo)
+	medicalSvc := medicalservice.NewMedicalService(medicalRepo, cfg.Appointment.NoticePeriod)
 
 	// Initialize hiring service layers
 	hiringRepo := hiringrepo.NewHiringRepository(db)
@@ -60,33 +83,74 @@ func New(cfg *config.Config, db *sql.DB) *Server {
 	communityRepo := communityrepo.NewCommunityRepository(db)
 	communitySvc := communityservice.NewCommunityService(communityRepo)
 
-	// Initialize match service layers
-	matchRepo := matchrepo.NewMatchRepository(db)
-	matchSvc := matchservice.NewMatchService(matchRepo)
+	// Initialize review service layers
+	reviewRepo := reviewrepo.NewReviewRepository(db)
+	reviewSvc := reviewservice.NewReviewService(reviewRepo)
+
+	// Initialize statistics service layers. Performances are checked against
+	// the match's rules, which the match service created below resolves.
+	var matchSvc matchdomain.MatchService
+	statisticsRepo := statisticsrepo.NewStatisticsRepository(db)
+	statisticsSvc := statisticsservice.NewStatisticsService(statisticsRepo,
+		func(matchID uuid.UUID) (*statisticsdomain.MatchLimits, error) {
+			ctx := context.Background()
+			match, err := matchSvc.GetMatch(ctx, matchID)
+			if err != nil {
+				return nil, err
+			}
+			rules, err := matchSvc.GetMatchRules(ctx, matchID)
+			if err != nil {
+				return nil, err
+			}
+			return &statisticsdomain.MatchLimits{
+				BowlerQuota: rules.BowlerQuota(match.TotalOvers),
+				RetiredOut:  rules.RetiredOut,
+			}, nil
+		},
+	)
 
 	// Initialize tournament service layers
 	tournamentRepo := tournamentrepo.NewTournamentRepository(db)
 	tournamentSvc := tournamentservice.NewTournamentService(tournamentRepo)
 
-	// Initialize statistics service layers
-	statisticsRepo := statisticsrepo.NewStatisticsRepository(db)
-	statisticsSvc := statisticsservice.NewStatisticsService(statisticsRepo)
+	// Initialize match service layers
+	matchRepo := matchrepo.NewMatchRepository(db)
+	matchSvc = matchservice.NewMatchService(matchRepo,
+		func(ctx context.Context, playerID uuid.UUID, matchDate time.Time) (string, error) {
+			return medicalSvc.FitnessWarning(ctx, playerID.String(), matchDate.Format("2006-01-02"))
+		},
+		matchlive.NewHub(cfg.Live.HistorySize),
+		func(ctx context.Context, m *matchdomain.Match) error {
+			_, err := statisticsSvc.BuildMatchPerformances(m.ID)
+			return err
+		},
+		func(ctx context.Context, m *matchdomain.Match) error {
+			return tournamentSvc.HandleMatchCompleted(ctx, m.ID)
+		},
+	)
 
 	return &Server{
 		config:            cfg,
 		db:                db,
+		groundSvc:         groundSvc,
 		authHandler:       authhttp.NewAuthHandler(db, cfg),
 		userHandler:       userhttp.NewUserHandler(db),
-		groundHandler:     groundhttp.NewGroundHandler(db),
+		groundHandler:     groundhttp.NewGroundHandler(groundSvc),
 		medicalHandler:    medicalhttp.NewMedicalHandler(medicalSvc),
 		hiringHandler:     hiringhttp.NewHiringHandler(hiringSvc),
 		communityHandler:  communityhttp.NewCommunityHandler(communitySvc),
 		matchHandler:      matchhttp.NewMatchHandler(matchSvc),
 		tournamentHandler: tournamenthttp.NewTournamentHandler(tournamentSvc),
 		statisticsHandler: statisticshttp.NewStatisticsHandler(statisticsSvc),
+		reviewHandler:     reviewhttp.NewReviewHandler(reviewSvc),
 	}
 }
 
+// StartBackgroundJobs runs the periodic maintenance tasks until ctx is done
+func (s *Server) StartBackgroundJobs(ctx context.Context) {
+	go groundservice.RunBookingSweeper(ctx, s.groundSvc, s.config.Booking.SweepInterval)
+}
+
 func (s *Server) Router() http.Handler {
 	r := chi.NewRouter()
 
@@ -94,6 +158,9 @@ func (s *Server) Router() http.Handler {
 	r.Use(chimiddleware.Logger)
 	r.Use(chimiddleware.Recoverer)
 	r.Use(chimiddleware.RequestID)
+	if s.config.Server.TrustProxyHeaders {
+		r.Use(chimiddleware.RealIP)
+	}
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   s.config.Server.AllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
@@ -106,19 +173,34 @@ func (s *Server) Router() http.Handler {
 	// Health check
 	r.Get("/health", s.handleHealth)
 
+	// Uploaded files
+	uploads := "/" + strings.Trim(s.config.Storage.UploadURL, "/") + "/"
+	r.Handle(uploads+"*", http.StripPrefix(uploads, http.FileServer(filesOnly{http.Dir(s.config.Storage.UploadDir)})))
+
 	// API routes
 	r.Route("/api/v1", func(r chi.Router) {
-		// Public auth routes
-		r.Post("/auth/register", s.authHandler.Register)
-		r.Post("/auth/login", s.authHandler.Login)
+		// Public auth routes, sharing one rate limit budget per client
+		authLimited := r.With(middleware.RateLimit(s.config.RateLimit.Auth, s.config.RateLimit.Window))
+		authLimited.Post("/auth/register", s.authHandler.Register)
+		authLimited.Post("/auth/login", s.authHandler.Login)
+		r.Post("/auth/refresh", s.authHandler.Refresh)
+		r.Post("/auth/logout", s.authHandler.Logout)
+		authLimited.Post("/auth/verify-email", s.authHandler.VerifyEmail)
+		authLimited.Post("/auth/forgot-password", s.authHandler.ForgotPassword)
+		authLimited.Post("/auth/reset-password", s.authHandler.ResetPassword)
 
 		// Public ground routes (no auth required for browsing)
 		r.Get("/grounds", s.groundHandler.ListGrounds)
 		r.Get("/grounds/{id}", s.groundHandler.GetGroundDetails)
+		r.Get("/grounds/{id}/availability", s.groundHandler.GetAvailability)
+		r.Get("/grounds/{id}/reviews", s.reviewHandler.ListGroundReviews)
 
 		// Public medical routes (browse physiotherapists)
 		r.Get("/physiotherapists", s.medicalHandler.ListPhysiotherapists)
 		r.Get("/physiotherapists/{id}", s.medicalHandler.GetPhysiotherapistDetails)
+		r.Get("/physiotherapists/{id}/reviews", s.reviewHandler.ListPhysiotherapistReviews)
+		r.Get("/physiotherapists/{id}/schedule", s.medicalHandler.GetSchedule)
+		r.Get("/physiotherapists/{id}/slots", s.medicalHandler.GetSlots)
 
 		// Public job listing routes (browse jobs)
 		r.Get("/jobs", s.hiringHandler.ListJobs)
@@ -136,7 +218,13 @@ func (s *Server) Router() http.Handler {
 		r.Get("/teams/{id}/players", s.matchHandler.ListTeamPlayers)
 		r.Get("/matches", s.matchHandler.ListMatches)
 		r.Get("/matches/{id}", s.matchHandler.GetMatch)
+		r.Get("/matches/{id}/rules", s.matchHandler.GetMatchRules)
 		r.Get("/matches/{id}/squad", s.matchHandler.GetMatchSquad)
+		r.Get("/matches/{id}/innings", s.matchHandler.ListInnings)
+		r.Get("/matches/{id}/deliveries", s.matchHandler.ListDeliveries)
+		r.Get("/matches/{id}/score", s.matchHandler.GetLiveScore)
+		r.Get("/matches/{id}/scorecard", s.matchHandler.GetScorecard)
+		r.Get("/matches/{id}/live", s.matchHandler.FollowMatch)
 		r.Get("/players/{id}", s.matchHandler.GetPlayer)
 
 		// Public tournament routes (browse tournaments)
@@ -145,6 +233,7 @@ func (s *Server) Router() http.Handler {
 		r.Get("/tournaments/{id}/registrations", s.tournamentHandler.ListRegistrations)
 		r.Get("/tournaments/{id}/standings", s.tournamentHandler.GetStandings)
 		r.Get("/tournaments/{id}/matches", s.tournamentHandler.GetTournamentMatches)
+		r.Get("/tournaments/{id}/bracket", s.tournamentHandler.GetBracket)
 
 		// Public statistics routes (browse stats and leaderboards)
 		r.Get("/performances", s.statisticsHandler.ListPerformances)
@@ -159,18 +248,74 @@ func (s *Server) Router() http.Handler {
 		r.Group(func(r chi.Router) {
 			r.Use(middleware.AuthMiddleware(s.config))
 
+			// Role-restricted route sets; admins pass every role check
+			groundOwner := r.With(middleware.RequireRole(authdomain.RoleGroundOwner))
+			physio := r.With(middleware.RequireRole(authdomain.RolePhysio))
+			admin := r.With(middleware.RequireRole(authdomain.RoleAdmin))
+			organizer := r.With(middleware.RequireRole(authdomain.RoleOrganizer))
+			matchManager := r.With(middleware.RequireRole(authdomain.RoleTeamManager, authdomain.RoleOrganizer))
+
+			r.Post("/auth/logout-all", s.authHandler.LogoutAll)
+			r.Post("/auth/verify-email/resend", s.authHandler.ResendVerification)
+
 			// User profile endpoints
 			r.Get("/users/profile", s.userHandler.GetProfile)
 			r.Put("/users/profile", s.userHandler.UpdateProfile)
 			r.Get("/users/{id}", s.userHandler.GetUserByID)
 
+			// Ground owner endpoints
+			groundOwner.Get("/grounds/my", s.groundHandler.ListMyGrounds)
+			groundOwner.Post("/grounds", s.groundHandler.CreateGround)
+			groundOwner.Put("/grounds/{id}", s.groundHandler.UpdateGround)
+			groundOwner.Put("/grounds/{id}/deactivate", s.groundHandler.DeactivateGround)
+			groundOwner.Put("/grounds/{id}/activate", s.groundHandler.ActivateGround)
+			groundOwner.Post("/grounds/{id}/images", s.groundHandler.UploadGroundImage)
+			groundOwner.Delete("/grounds/{id}/images", s.groundHandler.DeleteGroundImage)
+			groundOwner.Put("/grounds/{id}/availability", s.groundHandler.UpdateAvailability)
+			groundOwner.Post("/grounds/{id}/blackouts", s.groundHandler.AddBlackoutDate)
+			groundOwner.Delete("/grounds/{id}/blackouts/{blackoutId}", s.groundHandler.DeleteBlackoutDate)
+
 			// Booking endpoints
 			r.Post("/bookings", s.groundHandler.CreateBooking)
 			r.Get("/bookings/my", s.groundHandler.GetUserBookings)
+			groundOwner.Get("/bookings/owner", s.groundHandler.ListOwnerBookings)
+			groundOwner.Post("/bookings/{id}/confirm", s.groundHandler.ConfirmBooking)
+			groundOwner.Post("/bookings/{id}/reject", s.groundHandler.RejectBooking)
+			r.Post("/bookings/{id}/cancel", s.groundHandler.CancelBooking)
 
 			// Medical/Appointment endpoints
 			r.Post("/appointments", s.medicalHandler.CreateAppointment)
 			r.Get("/appointments/my", s.medicalHandler.GetMyAppointments)
+			physio.Get("/appointments/physio", s.medicalHandler.GetPhysioAppointments)
+			physio.Post("/appointments/{id}/complete", s.medicalHandler.CompleteAppointment)
+			physio.Post("/appointments/{id}/no-show", s.medicalHandler.MarkNoShow)
+			r.Post("/appointments/{id}/cancel", s.medicalHandler.CancelAppointment)
+			r.Post("/appointments/{id}/reschedule", s.medicalHandler.RescheduleAppointment)
+			r.Get("/appointments/{id}/history", s.medicalHandler.GetAppointmentHistory)
+			physio.Put("/physiotherapists/{id}/schedule", s.medicalHandler.UpdateSchedule)
+			physio.Post("/physiotherapists/{id}/leave", s.medicalHandler.AddLeaveDay)
+			physio.Delete("/physiotherapists/{id}/leave/{leaveId}", s.medicalHandler.DeleteLeaveDay)
+			physio.Post("/physiotherapists/apply", s.medicalHandler.ApplyAsPhysio)
+			physio.Get("/physiotherapists/application", s.medicalHandler.GetMyApplication)
+
+			// Admin review of physiotherapist applications
+			admin.Get("/admin/physio-applications", s.medicalHandler.ListApplications)
+			admin.Post("/admin/physio-applications/{id}/approve", s.medicalHandler.ApproveApplication)
+			admin.Post("/admin/physio-applications/{id}/reject", s.medicalHandler.RejectApplication)
+
+			// Injury and rehab endpoints
+			r.Post("/players/{id}/injuries", s.medicalHandler.ReportInjury)
+			r.Get("/players/{id}/injuries", s.medicalHandler.GetPlayerInjuries)
+			r.Get("/injuries/{id}", s.medicalHandler.GetInjury)
+			r.Put("/injuries/{id}", s.medicalHandler.UpdateInjury)
+			physio.Post("/injuries/{id}/rehab-plans", s.medicalHandler.CreateRehabPlan)
+			physio.Post("/rehab-plans/{id}/sessions", s.medicalHandler.AddRehabSession)
+			r.Get("/teams/{id}/availability", s.medicalHandler.GetTeamAvailability)
+
+			// Review endpoints
+			r.Post("/bookings/{id}/review", s.reviewHandler.ReviewBooking)
+			r.Post("/appointments/{id}/review", s.reviewHandler.ReviewAppointment)
+			r.Post("/reviews/{id}/reply", s.reviewHandler.ReplyToReview)
 
 			// Job posting endpoints
 			r.Post("/jobs", s.hiringHandler.CreateJob)
@@ -184,13 +329,14 @@ func (s *Server) Router() http.Handler {
 			r.Put("/applications/{id}/status", s.hiringHandler.UpdateApplicationStatus)
 
 			// Community post endpoints
-			r.Post("/posts", s.communityHandler.CreatePost)
+			postLimited := r.With(middleware.RateLimit(s.config.RateLimit.Posts, s.config.RateLimit.Window))
+			postLimited.Post("/posts", s.communityHandler.CreatePost)
 			r.Put("/posts/{id}", s.communityHandler.UpdatePost)
 			r.Delete("/posts/{id}", s.communityHandler.DeletePost)
 			r.Get("/users/{userId}/posts", s.communityHandler.GetUserPosts)
 
 			// Community comment endpoints
-			r.Post("/posts/{id}/comments", s.communityHandler.AddComment)
+			postLimited.Post("/posts/{id}/comments", s.communityHandler.AddComment)
 			r.Delete("/comments/{commentId}", s.communityHandler.DeleteComment)
 
 			// Community like endpoints
@@ -199,16 +345,37 @@ func (s *Server) Router() http.Handler {
 			r.Post("/comments/{commentId}/like", s.communityHandler.LikeComment)
 			r.Delete("/comments/{commentId}/like", s.communityHandler.UnlikeComment)
 
-			// Team management endpoints
-			r.Post("/teams", s.matchHandler.CreateTeam)
+			// Team management endpoints. Anything done to an existing team is
+			// authorized by the caller's role within that team.
+			matchManager.Post("/teams", s.matchHandler.CreateTeam)
 			r.Put("/teams/{id}", s.matchHandler.UpdateTeam)
 			r.Delete("/teams/{id}", s.matchHandler.DeleteTeam)
+			r.Get("/teams/{id}/roles", s.matchHandler.ListTeamRoles)
+			r.Put("/teams/{id}/roles", s.matchHandler.AssignTeamRole)
+			r.Delete("/teams/{id}/roles/{userId}", s.matchHandler.RemoveTeamRole)
+			r.Post("/teams/{id}/transfer-ownership", s.matchHandler.TransferTeamOwnership)
 
 			// Player management endpoints
 			r.Post("/players", s.matchHandler.AddPlayer)
 			r.Delete("/players/{id}", s.matchHandler.RemovePlayer)
 
-			// Match management endpoints
+			// Team membership endpoints
+			r.Post("/teams/{id}/invitations", s.matchHandler.InvitePlayer)
+			r.Get("/teams/{id}/invitations", s.matchHandler.ListTeamInvitations)
+			r.Post("/teams/{id}/join-requests", s.matchHandler.RequestToJoinTeam)
+			r.Get("/teams/{id}/join-requests", s.matchHandler.ListTeamJoinRequests)
+			r.Get("/invitations/my", s.matchHandler.GetMyInvitations)
+			r.Post("/invitations/{id}/accept", s.matchHandler.AcceptInvitation)
+			r.Post("/invitations/{id}/decline", s.matchHandler.DeclineInvitation)
+			r.Delete("/invitations/{id}", s.matchHandler.CancelInvitation)
+			r.Get("/join-requests/my", s.matchHandler.GetMyJoinRequests)
+			r.Post("/join-requests/{id}/approve", s.matchHandler.ApproveJoinRequest)
+			r.Post("/join-requests/{id}/reject", s.matchHandler.RejectJoinRequest)
+			r.Delete("/join-requests/{id}", s.matchHandler.CancelJoinRequest)
+
+			// Match management endpoints. Anything done to an existing match,
+			// scoring and its performances included, is authorized by the
+			// caller being the match's creator.
 			r.Post("/matches", s.matchHandler.CreateMatch)
 			r.Put("/matches/{id}", s.matchHandler.UpdateMatch)
 			r.Put("/matches/{id}/status", s.matchHandler.UpdateMatchStatus)
@@ -218,28 +385,39 @@ func (s *Server) Router() http.Handler {
 			r.Post("/matches/{id}/squad", s.matchHandler.AddPlayerToSquad)
 			r.Delete("/matches/{matchId}/squad/{playerId}", s.matchHandler.RemovePlayerFromSquad)
 
+			// Ball-by-ball scoring endpoints
+			r.Post("/matches/{id}/deliveries", s.matchHandler.RecordDelivery)
+			r.Delete("/matches/{id}/deliveries/last", s.matchHandler.UndoLastDelivery)
+			r.Post("/matches/{id}/innings", s.matchHandler.StartInnings)
+			r.Post("/matches/{id}/innings/{number}/declare", s.matchHandler.DeclareInnings)
+			r.Post("/matches/{id}/innings/{number}/revise", s.matchHandler.ReviseInnings)
+
 			// Tournament management endpoints
-			r.Post("/tournaments", s.tournamentHandler.CreateTournament)
-			r.Put("/tournaments/{id}", s.tournamentHandler.UpdateTournament)
-			r.Delete("/tournaments/{id}", s.tournamentHandler.DeleteTournament)
-			r.Post("/tournaments/{id}/open-registration", s.tournamentHandler.OpenRegistration)
-			r.Post("/tournaments/{id}/close-registration", s.tournamentHandler.CloseRegistration)
-			r.Post("/tournaments/{id}/start", s.tournamentHandler.StartTournament)
-			r.Post("/tournaments/{id}/complete", s.tournamentHandler.CompleteTournament)
-			r.Post("/tournaments/{id}/cancel", s.tournamentHandler.CancelTournament)
+			organizer.Post("/tournaments", s.tournamentHandler.CreateTournament)
+			organizer.Put("/tournaments/{id}", s.tournamentHandler.UpdateTournament)
+			organizer.Delete("/tournaments/{id}", s.tournamentHandler.DeleteTournament)
+			organizer.Post("/tournaments/{id}/open-registration", s.tournamentHandler.OpenRegistration)
+			organizer.Post("/tournaments/{id}/close-registration", s.tournamentHandler.CloseRegistration)
+			organizer.Post("/tournaments/{id}/start", s.tournamentHandler.StartTournament)
+			organizer.Post("/tournaments/{id}/complete", s.tournamentHandler.CompleteTournament)
+			organizer.Post("/tournaments/{id}/cancel", s.tournamentHandler.CancelTournament)
 
 			// Tournament registration endpoints
 			r.Post("/tournaments/{id}/register", s.tournamentHandler.RegisterTeam)
-			r.Post("/registrations/{registrationId}/approve", s.tournamentHandler.ApproveRegistration)
-			r.Post("/registrations/{registrationId}/reject", s.tournamentHandler.RejectRegistration)
+			organizer.Post("/registrations/{registrationId}/approve", s.tournamentHandler.ApproveRegistration)
+			organizer.Post("/registrations/{registrationId}/reject", s.tournamentHandler.RejectRegistration)
 			r.Delete("/tournaments/{id}/teams/{teamId}/withdraw", s.tournamentHandler.WithdrawRegistration)
 
+			// Knockout management endpoints
+			organizer.Post("/tournaments/{id}/matches/{matchId}/resolve", s.tournamentHandler.ResolveKnockoutMatch)
+
 			// Statistics management endpoints
 			r.Post("/performances", s.statisticsHandler.RecordPerformance)
 			r.Put("/performances/{id}", s.statisticsHandler.UpdatePerformance)
 			r.Delete("/performances/{id}", s.statisticsHandler.DeletePerformance)
-			r.Post("/players/{id}/refresh-stats", s.statisticsHandler.RefreshPlayerStats)
-			r.Post("/leaderboards/refresh", s.statisticsHandler.RefreshLeaderboards)
+			admin.Post("/players/{id}/refresh-stats", s.statisticsHandler.RefreshPlayerStats)
+			admin.Post("/matches/{id}/performances/rebuild", s.statisticsHandler.BuildMatchPerformances)
+			admin.Post("/leaderboards/refresh", s.statisticsHandler.RefreshLeaderboards)
 		})
 	})
 
@@ -268,3 +446,28 @@ func (s *Server) respondJSON(w http.ResponseWriter, status int, data interface{}
 	w.WriteHeader(status)
 	json.NewEncoder(w).Encode(data)
 }
+
+// filesOnly serves files but not directory listings, so uploaded file names
+// cannot be browsed
+type filesOnly struct {
+	fs http.FileSystem
+}
+
+func (f filesOnly) Open(name string) (http.File, error) {
+	file, err := f.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		file.Close()
+		return nil, os.ErrNotExist
+	}
+
+	return file, nil
+}
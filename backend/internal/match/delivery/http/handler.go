@@ -281,6 +281,23 @@ func (h *MatchHandler) GetMatch(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(match)
 }
 
+func (h *MatchHandler) GetMatchRules(w http.ResponseWriter, r *http.Request) {
+	matchID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid match ID", http.StatusBadRequest)
+		return
+	}
+
+	rules, err := h.service.GetMatchRules(r.Context(), matchID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(rules)
+}
+
 func (h *MatchHandler) ListMatches(w http.ResponseWriter, r *http.Request) {
 	filters := domain.MatchFilters{}
 
@@ -0,0 +1,130 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// liveHeartbeat is how often an idle live stream sends a comment so proxies
+// do not close the connection
+const liveHeartbeat = 15 * time.Second
+
+// Live Handlers
+
+// FollowMatch streams match events as server-sent events. A reconnecting
+// client sends the Last-Event-ID header (or ?last_event_id=) and receives the
+// events it missed, or a fresh snapshot when they are no longer available or
+// the ID belongs to an earlier feed.
+func (h *MatchHandler) FollowMatch(w http.ResponseWriter, r *http.Request) {
+	matchID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid match ID", http.StatusBadRequest)
+		return
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+	epoch, lastSequence, err := parseEventID(lastEventID)
+	if err != nil {
+		http.Error(w, "Invalid last event ID", http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	feed, err := h.service.FollowMatch(r.Context(), matchID, epoch, lastSequence)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	defer feed.Subscription.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if feed.Snapshot != nil {
+		snapshot := domain.MatchEvent{
+			Epoch:     feed.Subscription.Epoch,
+			Sequence:  feed.Subscription.LastSequence,
+			MatchID:   matchID,
+			Type:      domain.EventSnapshot,
+			Data:      feed.Snapshot,
+			CreatedAt: time.Now(),
+		}
+		if err := writeEvent(w, snapshot); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(liveHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-feed.Subscription.Events:
+			if !ok {
+				// Dropped for falling behind; the client reconnects with its last event ID
+				return
+			}
+			if err := writeEvent(w, event); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// writeEvent writes a match event as a server-sent event with a JSON payload
+func writeEvent(w http.ResponseWriter, event domain.MatchEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s:%d\nevent: %s\ndata: %s\n\n", event.Epoch, event.Sequence, event.Type, payload)
+	return err
+}
+
+// parseEventID splits an event ID written by writeEvent into its epoch and
+// sequence. A bare sequence, as sent by older clients, has no epoch and so
+// never resumes.
+func parseEventID(id string) (string, int64, error) {
+	if id == "" {
+		return "", 0, nil
+	}
+
+	epoch, sequence := "", id
+	if i := strings.LastIndex(id, ":"); i >= 0 {
+		epoch, sequence = id[:i], id[i+1:]
+	}
+
+	seq, err := strconv.ParseInt(sequence, 10, 64)
+	if err != nil || seq < 0 {
+		return "", 0, fmt.Errorf("invalid event ID")
+	}
+	return epoch, seq, nil
+}
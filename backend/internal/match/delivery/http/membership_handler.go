@@ -0,0 +1,198 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// Membership Handlers
+
+func (h *MatchHandler) InvitePlayer(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid team ID", http.StatusBadRequest)
+		return
+	}
+
+	var req domain.InvitePlayerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusUnauthorized)
+		return
+	}
+
+	invitation, err := h.service.InvitePlayer(r.Context(), teamID, req, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(invitation)
+}
+
+func (h *MatchHandler) RequestToJoinTeam(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid team ID", http.StatusBadRequest)
+		return
+	}
+
+	var req domain.JoinTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusUnauthorized)
+		return
+	}
+
+	joinRequest, err := h.service.RequestToJoinTeam(r.Context(), teamID, req, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(joinRequest)
+}
+
+func (h *MatchHandler) ListTeamInvitations(w http.ResponseWriter, r *http.Request) {
+	h.listTeamMembershipRequests(w, r, domain.MembershipInvitation)
+}
+
+func (h *MatchHandler) ListTeamJoinRequests(w http.ResponseWriter, r *http.Request) {
+	h.listTeamMembershipRequests(w, r, domain.MembershipJoinRequest)
+}
+
+func (h *MatchHandler) listTeamMembershipRequests(w http.ResponseWriter, r *http.Request, kind string) {
+	teamID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid team ID", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusUnauthorized)
+		return
+	}
+
+	response, err := h.service.ListTeamMembershipRequests(r.Context(), teamID, kind, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *MatchHandler) GetMyInvitations(w http.ResponseWriter, r *http.Request) {
+	h.listMyMembershipRequests(w, r, domain.MembershipInvitation)
+}
+
+func (h *MatchHandler) GetMyJoinRequests(w http.ResponseWriter, r *http.Request) {
+	h.listMyMembershipRequests(w, r, domain.MembershipJoinRequest)
+}
+
+func (h *MatchHandler) listMyMembershipRequests(w http.ResponseWriter, r *http.Request, kind string) {
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusUnauthorized)
+		return
+	}
+
+	response, err := h.service.ListUserMembershipRequests(r.Context(), kind, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *MatchHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	h.respondToMembershipRequest(w, r, h.service.RespondToInvitation, true)
+}
+
+func (h *MatchHandler) DeclineInvitation(w http.ResponseWriter, r *http.Request) {
+	h.respondToMembershipRequest(w, r, h.service.RespondToInvitation, false)
+}
+
+func (h *MatchHandler) ApproveJoinRequest(w http.ResponseWriter, r *http.Request) {
+	h.respondToMembershipRequest(w, r, h.service.ReviewJoinRequest, true)
+}
+
+func (h *MatchHandler) RejectJoinRequest(w http.ResponseWriter, r *http.Request) {
+	h.respondToMembershipRequest(w, r, h.service.ReviewJoinRequest, false)
+}
+
+func (h *MatchHandler) respondToMembershipRequest(w http.ResponseWriter, r *http.Request,
+	respond func(ctx context.Context, requestID uuid.UUID, accept bool, userID uuid.UUID) (*domain.MembershipRequest, error), accept bool) {
+	requestID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid request ID", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusUnauthorized)
+		return
+	}
+
+	response, err := respond(r.Context(), requestID, accept, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *MatchHandler) CancelInvitation(w http.ResponseWriter, r *http.Request) {
+	h.cancelMembershipRequest(w, r, domain.MembershipInvitation)
+}
+
+func (h *MatchHandler) CancelJoinRequest(w http.ResponseWriter, r *http.Request) {
+	h.cancelMembershipRequest(w, r, domain.MembershipJoinRequest)
+}
+
+func (h *MatchHandler) cancelMembershipRequest(w http.ResponseWriter, r *http.Request, kind string) {
+	requestID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid request ID", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.service.CancelMembershipRequest(r.Context(), requestID, kind, userID); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,164 @@
+package http
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+)
+
+// renderScorecardText writes a scorecard as plain text suited to pasting into chat apps
+func renderScorecardText(w io.Writer, card *domain.Scorecard) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s vs %s\n", card.TeamA, card.TeamB)
+	fmt.Fprintln(&b, matchHeadline(card.Match))
+	fmt.Fprintf(&b, "%s\n", card.Summary)
+
+	for _, innings := range card.Innings {
+		fmt.Fprintf(&b, "\n%s: %s (%s ov)\n\n", inningsTitle(innings), inningsTotal(innings), innings.Overs)
+
+		tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "Batter\t\tR\tB\t4s\t6s\tSR")
+		for _, bat := range innings.Batting {
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\t%.2f\n", bat.Name, bat.Dismissal, bat.Runs, bat.Balls, bat.Fours, bat.Sixes, bat.StrikeRate)
+		}
+		tw.Flush()
+
+		fmt.Fprintf(&b, "Extras: %s\n", extrasText(innings.Extras))
+		fmt.Fprintf(&b, "Total: %s (%s ov, RR %.2f)\n", inningsTotal(innings), innings.Overs, innings.RunRate)
+		if len(innings.DidNotBat) > 0 {
+			fmt.Fprintf(&b, "Did not bat: %s\n", didNotBatText(innings.DidNotBat))
+		}
+		if len(innings.FallOfWickets) > 0 {
+			fmt.Fprintf(&b, "Fall of wickets: %s\n", fallOfWicketsText(innings.FallOfWickets))
+		}
+		fmt.Fprintln(&b)
+
+		tw = tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "Bowler\tO\tM\tR\tW\tEcon")
+		for _, bowl := range innings.Bowling {
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%.2f\n", bowl.Name, bowl.Overs, bowl.Maidens, bowl.Runs, bowl.Wickets, bowl.Economy)
+		}
+		tw.Flush()
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// renderScorecardHTML writes a scorecard as a standalone printable page, which
+// browsers can save as PDF
+func renderScorecardHTML(w io.Writer, card *domain.Scorecard) error {
+	return scorecardTemplate.Execute(w, card)
+}
+
+// matchHeadline describes format, venue and date of a match in one line
+func matchHeadline(match *domain.Match) string {
+	parts := []string{match.MatchFormat}
+	if match.TotalOvers > 0 && match.MatchFormat != "Test" {
+		parts[0] = fmt.Sprintf("%s (%d overs)", match.MatchFormat, match.TotalOvers)
+	}
+	if venue := strings.Trim(match.VenueName+", "+match.VenueCity, ", "); venue != "" {
+		parts = append(parts, venue)
+	}
+	parts = append(parts, match.MatchDate.Format("2 Jan 2006"))
+	return strings.Join(parts, " · ")
+}
+
+// inningsTitle names an innings ("Lions innings", "Tigers super over")
+func inningsTitle(innings domain.InningsCard) string {
+	if innings.IsSuperOver {
+		return innings.BattingTeam + " super over"
+	}
+	if innings.FollowOn {
+		return innings.BattingTeam + " innings (following on)"
+	}
+	return innings.BattingTeam + " innings"
+}
+
+// inningsTotal renders runs and wickets, marking a declaration ("312/7d")
+func inningsTotal(innings domain.InningsCard) string {
+	total := fmt.Sprintf("%d/%d", innings.Runs, innings.Wickets)
+	if innings.Declared {
+		total += "d"
+	}
+	return total
+}
+
+func extrasText(e domain.ExtrasBreakdown) string {
+	return fmt.Sprintf("%d (w %d, nb %d, b %d, lb %d, p %d)", e.Total, e.Wides, e.NoBalls, e.Byes, e.LegByes, e.Penalty)
+}
+
+func didNotBatText(players []domain.ScorecardPlayer) string {
+	names := make([]string, len(players))
+	for i, p := range players {
+		names[i] = p.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func fallOfWicketsText(fow []domain.FallOfWicket) string {
+	parts := make([]string, len(fow))
+	for i, f := range fow {
+		parts[i] = fmt.Sprintf("%d-%d (%s, %s ov)", f.Wicket, f.Runs, f.Name, f.Overs)
+	}
+	return strings.Join(parts, ", ")
+}
+
+var scorecardTemplate = template.Must(template.New("scorecard").Funcs(template.FuncMap{
+	"headline":      matchHeadline,
+	"title":         inningsTitle,
+	"total":         inningsTotal,
+	"extras":        extrasText,
+	"didNotBat":     didNotBatText,
+	"fallOfWickets": fallOfWicketsText,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.TeamA}} vs {{.TeamB}} scorecard</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Roboto, sans-serif; margin: 2rem auto; max-width: 48rem; padding: 0 1rem; color: #222; }
+h1 { font-size: 1.5rem; margin-bottom: 0.25rem; }
+h2 { font-size: 1.15rem; margin: 2rem 0 0.5rem; border-bottom: 2px solid #1b5e20; padding-bottom: 0.25rem; }
+.meta { color: #666; margin: 0; }
+.summary { font-weight: 600; color: #1b5e20; }
+table { width: 100%; border-collapse: collapse; margin: 0.5rem 0; font-size: 0.9rem; }
+th, td { padding: 0.3rem 0.4rem; border-bottom: 1px solid #ddd; text-align: right; }
+th:first-child, td:first-child, td.dismissal { text-align: left; }
+td.dismissal { color: #555; }
+p.line { margin: 0.3rem 0; font-size: 0.9rem; }
+@media print { body { margin: 0; } h2 { break-after: avoid; } table { break-inside: avoid; } }
+</style>
+</head>
+<body>
+<h1>{{.TeamA}} vs {{.TeamB}}</h1>
+<p class="meta">{{headline .Match}}</p>
+<p class="summary">{{.Summary}}</p>
+{{range .Innings}}
+<h2>{{title .}}: {{total .}} ({{.Overs}} ov)</h2>
+<table>
+<thead><tr><th>Batter</th><th></th><th>R</th><th>B</th><th>4s</th><th>6s</th><th>SR</th></tr></thead>
+<tbody>
+{{range .Batting}}<tr><td>{{.Name}}</td><td class="dismissal">{{.Dismissal}}</td><td>{{.Runs}}</td><td>{{.Balls}}</td><td>{{.Fours}}</td><td>{{.Sixes}}</td><td>{{printf "%.2f" .StrikeRate}}</td></tr>
+{{end}}</tbody>
+</table>
+<p class="line">Extras: {{extras .Extras}}</p>
+<p class="line"><strong>Total: {{total .}} ({{.Overs}} ov, RR {{printf "%.2f" .RunRate}})</strong></p>
+{{if .DidNotBat}}<p class="line">Did not bat: {{didNotBat .DidNotBat}}</p>{{end}}
+{{if .FallOfWickets}}<p class="line">Fall of wickets: {{fallOfWickets .FallOfWickets}}</p>{{end}}
+<table>
+<thead><tr><th>Bowler</th><th>O</th><th>M</th><th>R</th><th>W</th><th>Econ</th></tr></thead>
+<tbody>
+{{range .Bowling}}<tr><td>{{.Name}}</td><td>{{.Overs}}</td><td>{{.Maidens}}</td><td>{{.Runs}}</td><td>{{.Wickets}}</td><td>{{printf "%.2f" .Economy}}</td></tr>
+{{end}}</tbody>
+</table>
+{{end}}
+</body>
+</html>
+`))
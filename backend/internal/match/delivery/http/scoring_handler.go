@@ -0,0 +1,151 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// Scoring Handlers
+
+func (h *MatchHandler) RecordDelivery(w http.ResponseWriter, r *http.Request) {
+	matchID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid match ID", http.StatusBadRequest)
+		return
+	}
+
+	var req domain.RecordDeliveryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusUnauthorized)
+		return
+	}
+
+	delivery, err := h.service.RecordDelivery(r.Context(), matchID, req, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(delivery)
+}
+
+func (h *MatchHandler) UndoLastDelivery(w http.ResponseWriter, r *http.Request) {
+	matchID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid match ID", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusUnauthorized)
+		return
+	}
+
+	delivery, err := h.service.UndoLastDelivery(r.Context(), matchID, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(delivery)
+}
+
+func (h *MatchHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	matchID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid match ID", http.StatusBadRequest)
+		return
+	}
+
+	var innings *int
+	if inningsStr := r.URL.Query().Get("innings"); inningsStr != "" {
+		if i, err := strconv.Atoi(inningsStr); err == nil {
+			innings = &i
+		}
+	}
+
+	deliveries, err := h.service.ListDeliveries(r.Context(), matchID, innings)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(deliveries)
+}
+
+func (h *MatchHandler) GetLiveScore(w http.ResponseWriter, r *http.Request) {
+	matchID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid match ID", http.StatusBadRequest)
+		return
+	}
+
+	score, err := h.service.GetLiveScore(r.Context(), matchID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(score)
+}
+
+// GetScorecard returns the full scorecard as JSON, or with ?format=text or
+// ?format=html as a plain text or printable page for sharing
+func (h *MatchHandler) GetScorecard(w http.ResponseWriter, r *http.Request) {
+	matchID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid match ID", http.StatusBadRequest)
+		return
+	}
+
+	card, err := h.service.GetScorecard(r.Context(), matchID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// Text and HTML are rendered in full first, so a failure can still be
+	// reported as an error instead of a truncated page
+	var body bytes.Buffer
+	var contentType string
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(card)
+		return
+	case "text":
+		contentType = "text/plain; charset=utf-8"
+		err = renderScorecardText(&body, card)
+	case "html":
+		contentType = "text/html; charset=utf-8"
+		err = renderScorecardHTML(&body, card)
+	default:
+		http.Error(w, "Invalid format, use json, text or html", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to render scorecard", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body.Bytes())
+}
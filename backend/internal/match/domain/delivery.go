@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Delivery represents a single ball recorded by the scorer
+type Delivery struct {
+	ID      uuid.UUID `json:"id" db:"id"`
+	MatchID uuid.UUID `json:"match_id" db:"match_id"`
+
+	// Position in the match
+	Innings    int `json:"innings" db:"innings"`
+	Sequence   int `json:"sequence" db:"sequence"`
+	OverNumber int `json:"over_number" db:"over_number"` // 0-based
+	BallNumber int `json:"ball_number" db:"ball_number"` // 1-6
+
+	// Participants
+	BattingTeamID uuid.UUID `json:"batting_team_id" db:"batting_team_id"`
+	BowlingTeamID uuid.UUID `json:"bowling_team_id" db:"bowling_team_id"`
+	StrikerID     uuid.UUID `json:"striker_id" db:"striker_id"`
+	NonStrikerID  uuid.UUID `json:"non_striker_id" db:"non_striker_id"`
+	BowlerID      uuid.UUID `json:"bowler_id" db:"bowler_id"`
+
+	// Runs
+	RunsOffBat int     `json:"runs_off_bat" db:"runs_off_bat"`
+	Extras     int     `json:"extras" db:"extras"`
+	ExtraType  *string `json:"extra_type,omitempty" db:"extra_type"` // wide, no_ball, bye, leg_bye, penalty
+
+	// Wicket
+	IsWicket          bool       `json:"is_wicket" db:"is_wicket"`
+	WicketType        *string    `json:"wicket_type,omitempty" db:"wicket_type"` // bowled, caught, lbw, run_out, stumped, etc.
+	DismissedPlayerID *uuid.UUID `json:"dismissed_player_id,omitempty" db:"dismissed_player_id"`
+	FielderID         *uuid.UUID `json:"fielder_id,omitempty" db:"fielder_id"`
+
+	CreatedBy uuid.UUID `json:"created_by" db:"created_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// RecordDeliveryRequest is the request for recording a delivery
+type RecordDeliveryRequest struct {
+	Innings           int        `json:"innings"`
+	BattingTeamID     uuid.UUID  `json:"batting_team_id"`
+	StrikerID         uuid.UUID  `json:"striker_id"`
+	NonStrikerID      uuid.UUID  `json:"non_striker_id"`
+	BowlerID          uuid.UUID  `json:"bowler_id"`
+	RunsOffBat        int        `json:"runs_off_bat"`
+	Extras            int        `json:"extras"`
+	ExtraType         *string    `json:"extra_type,omitempty"`
+	IsWicket          bool       `json:"is_wicket"`
+	WicketType        *string    `json:"wicket_type,omitempty"`
+	DismissedPlayerID *uuid.UUID `json:"dismissed_player_id,omitempty"`
+	FielderID         *uuid.UUID `json:"fielder_id,omitempty"`
+}
+
+// ExtrasBreakdown splits an innings' extras by type
+type ExtrasBreakdown struct {
+	Wides   int `json:"wides"`
+	NoBalls int `json:"no_balls"`
+	Byes    int `json:"byes"`
+	LegByes int `json:"leg_byes"`
+	Penalty int `json:"penalty"`
+	Total   int `json:"total"`
+}
+
+// Partnership is the stand between the two batters currently at the crease
+type Partnership struct {
+	BatterAID uuid.UUID `json:"batter_a_id"`
+	BatterBID uuid.UUID `json:"batter_b_id"`
+	Runs      int       `json:"runs"`
+	Balls     int       `json:"balls"`
+}
+
+// InningsScore is the running total of an innings derived from its deliveries
+type InningsScore struct {
+	Innings       int             `json:"innings"`
+	BattingTeamID uuid.UUID       `json:"batting_team_id"`
+	BowlingTeamID uuid.UUID       `json:"bowling_team_id"`
+	Runs          int             `json:"runs"`
+	Wickets       int             `json:"wickets"`
+	LegalBalls    int             `json:"legal_balls"`
+	Overs         string          `json:"overs"` // "12.3"
+	RunRate       float64         `json:"run_rate"`
+	Extras        ExtrasBreakdown `json:"extras"`
+	IsComplete    bool            `json:"is_complete"`
+	IsSuperOver   bool            `json:"is_super_over"`
+	FollowOn      bool            `json:"follow_on"`
+	Declared      bool            `json:"declared"`
+	MaxOvers      *int            `json:"max_overs,omitempty"` // revised if the innings was shortened
+	RevisedOvers  *int            `json:"revised_overs,omitempty"`
+	MaxWickets    int             `json:"max_wickets"`
+	Target        *int            `json:"target,omitempty"`
+}
+
+// LiveScore summarises the current state of a match from its deliveries
+type LiveScore struct {
+	MatchID         uuid.UUID      `json:"match_id"`
+	Status          string         `json:"status"`
+	TotalOvers      int            `json:"total_overs"`
+	Innings         []InningsScore `json:"innings"`
+	Current         *InningsScore  `json:"current,omitempty"`
+	Partnership     *Partnership   `json:"partnership,omitempty"`
+	Target          *int           `json:"target,omitempty"`
+	RunsRequired    *int           `json:"runs_required,omitempty"`
+	BallsRemaining  *int           `json:"balls_remaining,omitempty"`
+	RequiredRunRate *float64       `json:"required_run_rate,omitempty"`
+	LastDelivery    *Delivery      `json:"last_delivery,omitempty"`
+}
+
+// DeliveryListResponse contains the deliveries of a match
+type DeliveryListResponse struct {
+	Deliveries []Delivery `json:"deliveries"`
+	Total      int        `json:"total"`
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Innings is one side's turn to bat in a match. Regular innings are numbered
+// from 1; the super overs of a tied limited-overs match follow them in pairs.
+type Innings struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	MatchID       uuid.UUID `json:"match_id" db:"match_id"`
+	Number        int       `json:"number" db:"innings_number"`
+	BattingTeamID uuid.UUID `json:"batting_team_id" db:"batting_team_id"`
+	BowlingTeamID uuid.UUID `json:"bowling_team_id" db:"bowling_team_id"`
+
+	IsSuperOver bool `json:"is_super_over" db:"is_super_over"`
+	FollowOn    bool `json:"follow_on" db:"follow_on"`
+	Declared    bool `json:"declared" db:"declared"`
+	MaxOvers    *int `json:"max_overs,omitempty" db:"max_overs"` // nil when not limited by overs
+	// RevisedOvers shortens the innings after an interruption
+	RevisedOvers *int `json:"revised_overs,omitempty" db:"revised_overs"`
+	MaxWickets   int  `json:"max_wickets" db:"max_wickets"`
+	Target       *int `json:"target,omitempty" db:"target"` // before any revision
+
+	StartedBy *uuid.UUID `json:"started_by,omitempty" db:"started_by"`
+	StartedAt time.Time  `json:"started_at" db:"started_at"`
+}
+
+// OversLimit returns the overs the innings is limited to, revised if it was
+// shortened, or nil when it is not limited by overs
+func (i Innings) OversLimit() *int {
+	if i.RevisedOvers != nil {
+		return i.RevisedOvers
+	}
+	return i.MaxOvers
+}
+
+// StartInningsRequest starts the next innings of a live match. The first two
+// innings also start on their first recorded delivery.
+type StartInningsRequest struct {
+	BattingTeamID uuid.UUID `json:"batting_team_id"`
+	// FollowOn makes the side that batted in the previous innings bat again
+	FollowOn bool `json:"follow_on"`
+	// SuperOver starts a one-over eliminator after a tied limited-overs match
+	SuperOver bool `json:"super_over"`
+}
+
+// ReviseInningsRequest shortens the current innings of a limited-overs match
+// after an interruption
+type ReviseInningsRequest struct {
+	Overs int `json:"overs"`
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Live event types
+const (
+	EventSnapshot       = "snapshot"
+	EventDelivery       = "delivery"
+	EventWicket         = "wicket"
+	EventDeliveryUndone = "delivery_undone"
+	EventStatus         = "status"
+	EventSquad          = "squad"
+	EventInnings        = "innings"
+)
+
+// Squad change actions
+const (
+	SquadPlayerAdded   = "added"
+	SquadPlayerUpdated = "updated"
+	SquadPlayerRemoved = "removed"
+)
+
+// MatchEvent is a change to a match pushed to everyone following it live.
+// Sequence increases by one for each event published for the match and
+// restarts when the feed does, which starts a new Epoch.
+type MatchEvent struct {
+	Epoch     string      `json:"epoch"`
+	Sequence  int64       `json:"sequence"`
+	MatchID   uuid.UUID   `json:"match_id"`
+	Type      string      `json:"type"`
+	Data      interface{} `json:"data"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// Subscription delivers the events of one match to a single follower
+type Subscription struct {
+	// Events is closed when the subscription ends, including when the
+	// follower falls too far behind to keep up
+	Events <-chan MatchEvent
+	// Epoch identifies the feed the sequence numbers belong to
+	Epoch string
+	// LastSequence is the newest event published when the subscription began
+	LastSequence int64
+	// Resumed is true when the requested epoch is the feed's and every event
+	// after the requested sequence could be replayed, so the follower does not
+	// need a fresh snapshot
+	Resumed bool
+	Close   func()
+}
+
+// EventBroker fans match events out to live followers. The in-process hub
+// can be replaced by an external broker implementing the same interface.
+type EventBroker interface {
+	Publish(ctx context.Context, matchID uuid.UUID, eventType string, data interface{}) error
+	Subscribe(ctx context.Context, matchID uuid.UUID, epoch string, afterSequence int64) (*Subscription, error)
+}
+
+// LiveSnapshot is the full state sent when a follower cannot resume
+type LiveSnapshot struct {
+	Match *Match       `json:"match"`
+	Score *LiveScore   `json:"score"`
+	Squad []MatchSquad `json:"squad"`
+}
+
+// DeliveryEvent carries a recorded or undone delivery and the score after it
+type DeliveryEvent struct {
+	Delivery *Delivery  `json:"delivery"`
+	Score    *LiveScore `json:"score,omitempty"`
+}
+
+// StatusEvent carries a match status transition
+type StatusEvent struct {
+	PreviousStatus string `json:"previous_status"`
+	Match          *Match `json:"match"`
+}
+
+// SquadEvent carries a change to a match squad
+type SquadEvent struct {
+	Action   string      `json:"action"`
+	TeamID   uuid.UUID   `json:"team_id"`
+	PlayerID uuid.UUID   `json:"player_id"`
+	Squad    *MatchSquad `json:"squad,omitempty"`
+}
+
+// LiveFeed is a follower's view of a match: a snapshot, unless the
+// subscription resumed from the follower's last seen event, then the events
+type LiveFeed struct {
+	Snapshot     *LiveSnapshot
+	Subscription *Subscription
+}
@@ -85,7 +85,14 @@ type Match struct {
 	// Result (stored as JSONB)
 	WinnerTeamID *uuid.UUID `json:"winner_team_id,omitempty" db:"-"`
 	WinMargin    *string    `json:"win_margin,omitempty" db:"-"`  // "5 wickets", "50 runs"
-	ResultType   *string    `json:"result_type,omitempty" db:"-"` // normal, tie, no-result, abandoned
+	ResultType   *string    `json:"result_type,omitempty" db:"-"` // normal, tie, draw, no-result, abandoned
+	// Computed from the recorded innings when the match is completed
+	MarginRuns     *int    `json:"margin_runs,omitempty" db:"-"`
+	MarginWickets  *int    `json:"margin_wickets,omitempty" db:"-"`
+	BallsRemaining *int    `json:"balls_remaining,omitempty" db:"-"`
+	Target         *int    `json:"target,omitempty" db:"-"`
+	RevisedOvers   *int    `json:"revised_overs,omitempty" db:"-"`
+	OverrideReason *string `json:"override_reason,omitempty" db:"-"` // why a result contradicting the scores was recorded
 
 	// Management
 	CreatedBy   uuid.UUID `json:"created_by" db:"created_by"`
@@ -105,6 +112,9 @@ type MatchSquad struct {
 	IsViceCaptain  bool      `json:"is_vice_captain" db:"is_vice_captain"`
 	IsWicketKeeper bool      `json:"is_wicket_keeper" db:"is_wicket_keeper"`
 	AddedAt        time.Time `json:"added_at" db:"added_at"`
+
+	// FitnessWarning is set when a player named in the playing 11 may be injured
+	FitnessWarning string `json:"fitness_warning,omitempty" db:"-"`
 }
 
 // CreateTeamRequest is the request for creating a team
@@ -163,6 +173,11 @@ type UpdateMatchStatusRequest struct {
 	WinnerTeamID *uuid.UUID `json:"winner_team_id,omitempty"`
 	WinMargin    *string    `json:"win_margin,omitempty"`
 	ResultType   *string    `json:"result_type,omitempty"`
+	// RevisedOvers shortens the chase of a rain-affected limited-overs match;
+	// the target is scaled in proportion to the overs lost
+	RevisedOvers *int `json:"revised_overs,omitempty"`
+	// OverrideReason is required to record a result the scores contradict
+	OverrideReason *string `json:"override_reason,omitempty"`
 }
 
 // AddSquadPlayerRequest adds a player to match squad
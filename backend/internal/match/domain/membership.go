@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MembershipRequest is an invitation from a team to a user, or a user's
+// request to join a team. The user becomes a player once it is accepted.
+type MembershipRequest struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	TeamID       uuid.UUID  `json:"team_id" db:"team_id"`
+	TeamName     string     `json:"team_name" db:"-"`
+	UserID       uuid.UUID  `json:"user_id" db:"user_id"`
+	UserName     string     `json:"user_name" db:"-"`
+	Kind         string     `json:"kind" db:"kind"`     // invitation, join_request
+	Status       string     `json:"status" db:"status"` // pending, accepted, declined, cancelled
+	RequestedBy  uuid.UUID  `json:"requested_by" db:"requested_by"`
+	JerseyNumber int        `json:"jersey_number" db:"jersey_number"`
+	Role         string     `json:"role" db:"role"`
+	Batting      string     `json:"batting" db:"batting"`
+	Bowling      *string    `json:"bowling,omitempty" db:"bowling"`
+	Message      *string    `json:"message,omitempty" db:"message"`
+	RespondedBy  *uuid.UUID `json:"responded_by,omitempty" db:"responded_by"`
+	RespondedAt  *time.Time `json:"responded_at,omitempty" db:"responded_at"`
+	PlayerID     *uuid.UUID `json:"player_id,omitempty" db:"player_id"` // Set once accepted
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// Kinds of membership request
+const (
+	MembershipInvitation  = "invitation"
+	MembershipJoinRequest = "join_request"
+)
+
+// Membership request statuses
+const (
+	MembershipPending   = "pending"
+	MembershipAccepted  = "accepted"
+	MembershipDeclined  = "declined"
+	MembershipCancelled = "cancelled"
+)
+
+// MembershipFilters selects membership requests. Nil fields are not filtered on.
+type MembershipFilters struct {
+	TeamID *uuid.UUID
+	UserID *uuid.UUID
+	Kind   *string
+	Status *string
+}
+
+// InvitePlayerRequest is a team's invitation for a user to join as a player
+type InvitePlayerRequest struct {
+	UserID       uuid.UUID `json:"user_id"`
+	JerseyNumber int       `json:"jersey_number"`
+	Role         string    `json:"role"`
+	Batting      string    `json:"batting"`
+	Bowling      *string   `json:"bowling,omitempty"`
+	Message      *string   `json:"message,omitempty"`
+}
+
+// JoinTeamRequest is a user's request to join a team as a player
+type JoinTeamRequest struct {
+	JerseyNumber int     `json:"jersey_number"`
+	Role         string  `json:"role"`
+	Batting      string  `json:"batting"`
+	Bowling      *string `json:"bowling,omitempty"`
+	Message      *string `json:"message,omitempty"`
+}
+
+// MembershipListResponse is the response for listing membership requests
+type MembershipListResponse struct {
+	Requests []MembershipRequest `json:"requests"`
+	Total    int                 `json:"total"`
+}
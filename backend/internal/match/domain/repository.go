@@ -18,6 +18,13 @@ type MatchRepository interface {
 	UpdateTeam(ctx context.Context, team *Team) error
 	DeleteTeam(ctx context.Context, teamID uuid.UUID) error
 
+	// Team role operations
+	GetTeamRole(ctx context.Context, teamID, userID uuid.UUID) (string, error)
+	ListTeamRoles(ctx context.Context, teamID uuid.UUID) ([]TeamRole, error)
+	AssignTeamRole(ctx context.Context, role *TeamRole) error
+	RemoveTeamRole(ctx context.Context, teamID, userID uuid.UUID) error
+	TransferTeamOwnership(ctx context.Context, teamID, ownerID, newOwnerID uuid.UUID) error
+
 	// Player operations
 	AddPlayerToTeam(ctx context.Context, player *Player) error
 	GetPlayerByID(ctx context.Context, playerID uuid.UUID) (*Player, error)
@@ -26,6 +33,16 @@ type MatchRepository interface {
 	UpdatePlayer(ctx context.Context, player *Player) error
 	RemovePlayerFromTeam(ctx context.Context, playerID uuid.UUID) error
 
+	// Membership operations
+	CreateMembershipRequest(ctx context.Context, req *MembershipRequest) error
+	GetMembershipRequest(ctx context.Context, requestID uuid.UUID) (*MembershipRequest, error)
+	ListMembershipRequests(ctx context.Context, filters MembershipFilters) ([]MembershipRequest, error)
+	IsActiveTeamMember(ctx context.Context, teamID, userID uuid.UUID) (bool, error)
+	// AcceptMembershipRequest adds the user as a player of the team and marks
+	// the request accepted, provided it is still pending
+	AcceptMembershipRequest(ctx context.Context, req *MembershipRequest, respondedBy uuid.UUID) (*Player, error)
+	CloseMembershipRequest(ctx context.Context, requestID uuid.UUID, status string, respondedBy uuid.UUID) error
+
 	// Match operations
 	CreateMatch(ctx context.Context, match *Match) error
 	GetMatchByID(ctx context.Context, matchID uuid.UUID) (*Match, error)
@@ -40,6 +57,28 @@ type MatchRepository interface {
 	GetMatchSquad(ctx context.Context, matchID uuid.UUID) ([]MatchSquad, error)
 	GetTeamSquad(ctx context.Context, matchID, teamID uuid.UUID) ([]MatchSquad, error)
 	UpdateSquadPlayer(ctx context.Context, squad *MatchSquad) error
+
+	// Delivery operations
+	CreateDelivery(ctx context.Context, delivery *Delivery) error
+	ListDeliveries(ctx context.Context, matchID uuid.UUID, innings *int) ([]Delivery, error)
+	// UndoLastDelivery deletes the latest delivery of a match, and the innings
+	// it started when that innings has no other deliveries
+	UndoLastDelivery(ctx context.Context, matchID uuid.UUID) (*Delivery, error)
+
+	// Innings operations
+	CreateInnings(ctx context.Context, innings *Innings) error
+	ListInnings(ctx context.Context, matchID uuid.UUID) ([]Innings, error)
+	DeclareInnings(ctx context.Context, matchID uuid.UUID, number int) error
+	ReviseInnings(ctx context.Context, matchID uuid.UUID, number, overs int) error
+
+	// Scorecard operations
+	// GetMatchPlayerNames maps the players of both teams in a match to their names
+	GetMatchPlayerNames(ctx context.Context, matchID uuid.UUID) (map[uuid.UUID]string, error)
+
+	// Rules operations
+	// GetTournamentRules returns the rules of the tournament a match is part
+	// of, or nil for a match outside any tournament
+	GetTournamentRules(ctx context.Context, matchID uuid.UUID) (map[string]interface{}, error)
 }
 
 // MatchFilters contains filters for listing matches
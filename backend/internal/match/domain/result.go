@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// Match result types
+const (
+	ResultNormal    = "normal"
+	ResultTie       = "tie"
+	ResultDraw      = "draw"
+	ResultNoResult  = "no-result"
+	ResultAbandoned = "abandoned"
+)
+
+// MatchResult is the outcome of a match derived from its recorded innings
+type MatchResult struct {
+	ResultType     string     `json:"result_type"`
+	WinnerTeamID   *uuid.UUID `json:"winner_team_id,omitempty"`
+	WinMargin      string     `json:"win_margin,omitempty"` // "5 wickets", "32 runs", "an innings and 12 runs"
+	MarginRuns     *int       `json:"margin_runs,omitempty"`
+	MarginWickets  *int       `json:"margin_wickets,omitempty"`
+	BallsRemaining *int       `json:"balls_remaining,omitempty"`
+	// Target is the score the side batting last needed, revised
+	// proportionally when the chase was shortened
+	Target       *int `json:"target,omitempty"`
+	RevisedOvers *int `json:"revised_overs,omitempty"`
+}
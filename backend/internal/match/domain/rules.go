@@ -0,0 +1,145 @@
+package domain
+
+// Rule keys a tournament can set in its Rules to override a format's profile
+const (
+	RuleOversPerInnings   = "overs_per_innings"
+	RuleMaxOversPerBowler = "max_overs_per_bowler"
+	RulePowerplayOvers    = "powerplay_overs"
+	RuleWideRuns          = "wide_runs"
+	RuleNoBallRuns        = "no_ball_runs"
+	RuleFreeHit           = "free_hit"
+	RuleRetiredOut        = "retired_out"
+	RulePlayersPerSide    = "players_per_side"
+	RuleSquadSize         = "squad_size"
+	RuleFollowOnMargin    = "follow_on_margin"
+)
+
+// MatchRules is the rules profile a match is played under. Every format has
+// a default profile, which a tournament can override through its Rules.
+type MatchRules struct {
+	Format string `json:"format"`
+
+	// Overs
+	OversPerInnings   int `json:"overs_per_innings"`       // 0 when innings are not limited by overs
+	OversPerDay       int `json:"overs_per_day,omitempty"` // multi-day matches only
+	MaxOversPerBowler int `json:"max_overs_per_bowler"`    // 0 when bowlers have no quota
+	PowerplayOvers    int `json:"powerplay_overs"`
+
+	// Penalty runs for a wide or no-ball, before any runs taken off it
+	WideRuns   int `json:"wide_runs"`
+	NoBallRuns int `json:"no_ball_runs"`
+
+	// FreeHit makes the delivery after a no-ball a free hit, off which the
+	// batter can only be out in the ways a no-ball allows
+	FreeHit bool `json:"free_hit"`
+	// RetiredOut lets a batter retire out, which counts as a wicket
+	RetiredOut bool `json:"retired_out"`
+
+	// PlayersPerSide is the size of a playing XI; an innings is all out when
+	// one batter is left
+	PlayersPerSide int `json:"players_per_side"`
+	// SquadSize is the most players a team can name for a match, playing XI included
+	SquadSize int `json:"squad_size"`
+
+	// FollowOnMargin is the first innings lead that allows enforcing the
+	// follow-on, in multi-day matches only
+	FollowOnMargin int `json:"follow_on_margin,omitempty"`
+}
+
+var formatRules = map[string]MatchRules{
+	"T10": {
+		Format: "T10", OversPerInnings: 10, MaxOversPerBowler: 2, PowerplayOvers: 3,
+		WideRuns: 1, NoBallRuns: 1, FreeHit: true, RetiredOut: true,
+		PlayersPerSide: 11, SquadSize: 15,
+	},
+	"T20": {
+		Format: "T20", OversPerInnings: 20, MaxOversPerBowler: 4, PowerplayOvers: 6,
+		WideRuns: 1, NoBallRuns: 1, FreeHit: true, RetiredOut: true,
+		PlayersPerSide: 11, SquadSize: 15,
+	},
+	"ODI": {
+		Format: "ODI", OversPerInnings: 50, MaxOversPerBowler: 10, PowerplayOvers: 10,
+		WideRuns: 1, NoBallRuns: 1, FreeHit: true, RetiredOut: true,
+		PlayersPerSide: 11, SquadSize: 15,
+	},
+	"Test": {
+		Format: "Test", OversPerDay: 90,
+		WideRuns: 1, NoBallRuns: 1, RetiredOut: true,
+		PlayersPerSide: 11, SquadSize: 15, FollowOnMargin: 200,
+	},
+}
+
+// FormatRules returns the default rules profile of a match format, and
+// whether the format is known
+func FormatRules(format string) (MatchRules, bool) {
+	rules, ok := formatRules[format]
+	return rules, ok
+}
+
+// WithOverrides returns the profile with a tournament's rule values applied.
+// For multi-day formats overs_per_innings sets the overs per day, as it does
+// for generated fixtures, and follow_on_margin only applies to them. Values
+// of the wrong type are ignored.
+func (r MatchRules) WithOverrides(overrides map[string]interface{}) MatchRules {
+	if v, ok := intOverride(overrides, RuleOversPerInnings); ok {
+		if r.OversPerInnings > 0 {
+			r.OversPerInnings = v
+		} else {
+			r.OversPerDay = v
+		}
+	}
+	if v, ok := intOverride(overrides, RuleMaxOversPerBowler); ok {
+		r.MaxOversPerBowler = v
+	}
+	if v, ok := intOverride(overrides, RulePowerplayOvers); ok {
+		r.PowerplayOvers = v
+	}
+	if v, ok := intOverride(overrides, RuleWideRuns); ok {
+		r.WideRuns = v
+	}
+	if v, ok := intOverride(overrides, RuleNoBallRuns); ok {
+		r.NoBallRuns = v
+	}
+	if v, ok := overrides[RuleFreeHit].(bool); ok {
+		r.FreeHit = v
+	}
+	if v, ok := overrides[RuleRetiredOut].(bool); ok {
+		r.RetiredOut = v
+	}
+	if v, ok := intOverride(overrides, RulePlayersPerSide); ok {
+		r.PlayersPerSide = v
+	}
+	if v, ok := intOverride(overrides, RuleSquadSize); ok {
+		r.SquadSize = v
+	}
+	if v, ok := intOverride(overrides, RuleFollowOnMargin); ok && r.FollowOnMargin > 0 {
+		r.FollowOnMargin = v
+	}
+	if r.SquadSize < r.PlayersPerSide {
+		r.SquadSize = r.PlayersPerSide
+	}
+	return r
+}
+
+// BowlerQuota returns the overs one bowler may bowl in an innings of the
+// given length, or 0 when there is no quota. Shortened innings scale the
+// quota down, rounding up.
+func (r MatchRules) BowlerQuota(inningsOvers int) int {
+	if r.MaxOversPerBowler == 0 {
+		return 0
+	}
+	if r.OversPerInnings == 0 || inningsOvers <= 0 || inningsOvers >= r.OversPerInnings {
+		return r.MaxOversPerBowler
+	}
+	return (inningsOvers*r.MaxOversPerBowler + r.OversPerInnings - 1) / r.OversPerInnings
+}
+
+func intOverride(overrides map[string]interface{}, key string) (int, bool) {
+	switch v := overrides[key].(type) {
+	case float64:
+		return int(v), true
+	case int:
+		return v, true
+	}
+	return 0, false
+}
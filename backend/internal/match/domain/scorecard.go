@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// BattingEntry is one batter's line on the scorecard
+type BattingEntry struct {
+	PlayerID   uuid.UUID `json:"player_id"`
+	Name       string    `json:"name"`
+	Dismissal  string    `json:"dismissal"` // "c Smith b Jones", "not out"
+	IsOut      bool      `json:"is_out"`
+	Runs       int       `json:"runs"`
+	Balls      int       `json:"balls"`
+	Fours      int       `json:"fours"`
+	Sixes      int       `json:"sixes"`
+	StrikeRate float64   `json:"strike_rate"`
+}
+
+// BowlingEntry is one bowler's figures on the scorecard
+type BowlingEntry struct {
+	PlayerID   uuid.UUID `json:"player_id"`
+	Name       string    `json:"name"`
+	LegalBalls int       `json:"legal_balls"`
+	Overs      string    `json:"overs"`
+	Maidens    int       `json:"maidens"`
+	Runs       int       `json:"runs"`
+	Wickets    int       `json:"wickets"`
+	Wides      int       `json:"wides"`
+	NoBalls    int       `json:"no_balls"`
+	Economy    float64   `json:"economy"`
+}
+
+// FallOfWicket records the score when a batter was out
+type FallOfWicket struct {
+	Wicket   int       `json:"wicket"`
+	Runs     int       `json:"runs"`
+	Overs    string    `json:"overs"`
+	PlayerID uuid.UUID `json:"player_id"`
+	Name     string    `json:"name"`
+}
+
+// ScorecardPlayer names a player on the scorecard
+type ScorecardPlayer struct {
+	PlayerID uuid.UUID `json:"player_id"`
+	Name     string    `json:"name"`
+}
+
+// InningsCard is the full card of one innings
+type InningsCard struct {
+	InningsScore
+	BattingTeam   string            `json:"batting_team"`
+	BowlingTeam   string            `json:"bowling_team"`
+	Batting       []BattingEntry    `json:"batting"`
+	DidNotBat     []ScorecardPlayer `json:"did_not_bat"`
+	FallOfWickets []FallOfWicket    `json:"fall_of_wickets"`
+	Bowling       []BowlingEntry    `json:"bowling"`
+}
+
+// Scorecard is the complete scorecard of a match with a one-line summary
+type Scorecard struct {
+	Match   *Match        `json:"match"`
+	TeamA   string        `json:"team_a"`
+	TeamB   string        `json:"team_b"`
+	Innings []InningsCard `json:"innings"`
+	Summary string        `json:"summary"` // "Lions won by 5 wickets"
+}
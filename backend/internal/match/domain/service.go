@@ -2,10 +2,19 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// MatchCompletedHook is invoked after a match has been marked completed, so
+// other modules can react to the final result (statistics, standings, etc.)
+type MatchCompletedHook func(ctx context.Context, match *Match) error
+
+// PlayerFitnessCheck reports why a player may not be fit to play on the match
+// date, or returns an empty string when there is no known concern
+type PlayerFitnessCheck func(ctx context.Context, playerID uuid.UUID, matchDate time.Time) (string, error)
+
 // MatchService defines the business logic for matches
 type MatchService interface {
 	// Team operations
@@ -16,6 +25,12 @@ type MatchService interface {
 	UpdateTeam(ctx context.Context, teamID uuid.UUID, req CreateTeamRequest, userID uuid.UUID) (*Team, error)
 	DeleteTeam(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error
 
+	// Team role operations
+	ListTeamRoles(ctx context.Context, teamID uuid.UUID) ([]TeamRole, error)
+	AssignTeamRole(ctx context.Context, teamID uuid.UUID, req AssignTeamRoleRequest, userID uuid.UUID) (*TeamRole, error)
+	RemoveTeamRole(ctx context.Context, teamID, memberID uuid.UUID, userID uuid.UUID) error
+	TransferTeamOwnership(ctx context.Context, teamID uuid.UUID, req TransferOwnershipRequest, userID uuid.UUID) error
+
 	// Player operations
 	AddPlayer(ctx context.Context, req CreatePlayerRequest, userID uuid.UUID) (*Player, error)
 	GetPlayer(ctx context.Context, playerID uuid.UUID) (*Player, error)
@@ -23,6 +38,15 @@ type MatchService interface {
 	ListUserPlayers(ctx context.Context, userID uuid.UUID) (*PlayerListResponse, error)
 	RemovePlayer(ctx context.Context, playerID uuid.UUID, userID uuid.UUID) error
 
+	// Membership operations
+	InvitePlayer(ctx context.Context, teamID uuid.UUID, req InvitePlayerRequest, userID uuid.UUID) (*MembershipRequest, error)
+	RequestToJoinTeam(ctx context.Context, teamID uuid.UUID, req JoinTeamRequest, userID uuid.UUID) (*MembershipRequest, error)
+	ListTeamMembershipRequests(ctx context.Context, teamID uuid.UUID, kind string, userID uuid.UUID) (*MembershipListResponse, error)
+	ListUserMembershipRequests(ctx context.Context, kind string, userID uuid.UUID) (*MembershipListResponse, error)
+	RespondToInvitation(ctx context.Context, requestID uuid.UUID, accept bool, userID uuid.UUID) (*MembershipRequest, error)
+	ReviewJoinRequest(ctx context.Context, requestID uuid.UUID, approve bool, userID uuid.UUID) (*MembershipRequest, error)
+	CancelMembershipRequest(ctx context.Context, requestID uuid.UUID, kind string, userID uuid.UUID) error
+
 	// Match operations
 	CreateMatch(ctx context.Context, req CreateMatchRequest, userID uuid.UUID) (*Match, error)
 	GetMatch(ctx context.Context, matchID uuid.UUID) (*Match, error)
@@ -30,10 +54,27 @@ type MatchService interface {
 	UpdateMatch(ctx context.Context, matchID uuid.UUID, req UpdateMatchRequest, userID uuid.UUID) (*Match, error)
 	UpdateMatchStatus(ctx context.Context, matchID uuid.UUID, req UpdateMatchStatusRequest, userID uuid.UUID) (*Match, error)
 	DeleteMatch(ctx context.Context, matchID uuid.UUID, userID uuid.UUID) error
+	GetMatchRules(ctx context.Context, matchID uuid.UUID) (*MatchRules, error)
 
 	// Squad operations
 	AddPlayerToMatchSquad(ctx context.Context, matchID uuid.UUID, req AddSquadPlayerRequest, userID uuid.UUID) (*MatchSquad, error)
 	RemovePlayerFromMatchSquad(ctx context.Context, matchID, playerID uuid.UUID, userID uuid.UUID) error
 	GetMatchSquad(ctx context.Context, matchID uuid.UUID) ([]MatchSquad, error)
 	UpdateSquadPlayer(ctx context.Context, matchID uuid.UUID, req AddSquadPlayerRequest, userID uuid.UUID) (*MatchSquad, error)
+
+	// Scoring operations
+	RecordDelivery(ctx context.Context, matchID uuid.UUID, req RecordDeliveryRequest, userID uuid.UUID) (*Delivery, error)
+	UndoLastDelivery(ctx context.Context, matchID uuid.UUID, userID uuid.UUID) (*Delivery, error)
+	ListDeliveries(ctx context.Context, matchID uuid.UUID, innings *int) (*DeliveryListResponse, error)
+	GetLiveScore(ctx context.Context, matchID uuid.UUID) (*LiveScore, error)
+	GetScorecard(ctx context.Context, matchID uuid.UUID) (*Scorecard, error)
+
+	// Innings operations
+	StartInnings(ctx context.Context, matchID uuid.UUID, req StartInningsRequest, userID uuid.UUID) (*Innings, error)
+	ListInnings(ctx context.Context, matchID uuid.UUID) ([]Innings, error)
+	DeclareInnings(ctx context.Context, matchID uuid.UUID, number int, userID uuid.UUID) (*Innings, error)
+	ReviseInnings(ctx context.Context, matchID uuid.UUID, number int, req ReviseInningsRequest, userID uuid.UUID) (*Innings, error)
+
+	// Live updates
+	FollowMatch(ctx context.Context, matchID uuid.UUID, epoch string, lastSequence int64) (*LiveFeed, error)
 }
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Team roles. Each user holds at most one role per team.
+const (
+	TeamRoleOwner       = "owner"
+	TeamRoleManager     = "manager"
+	TeamRoleCaptain     = "captain"
+	TeamRoleViceCaptain = "vice_captain"
+)
+
+// Who may do what within a team
+var (
+	// Edit the team's details and appoint captains
+	TeamEditors = []string{TeamRoleOwner, TeamRoleManager}
+	// Add and remove players, handle invitations and join requests, create matches
+	TeamSelectors = []string{TeamRoleOwner, TeamRoleManager, TeamRoleCaptain}
+	// Pick match squads
+	TeamSquadPickers = []string{TeamRoleOwner, TeamRoleManager, TeamRoleCaptain, TeamRoleViceCaptain}
+)
+
+// TeamRole is a user's role within a team
+type TeamRole struct {
+	TeamID     uuid.UUID  `json:"team_id" db:"team_id"`
+	UserID     uuid.UUID  `json:"user_id" db:"user_id"`
+	UserName   string     `json:"user_name" db:"-"`
+	Role       string     `json:"role" db:"role"`
+	AssignedBy *uuid.UUID `json:"assigned_by,omitempty" db:"assigned_by"`
+	AssignedAt time.Time  `json:"assigned_at" db:"assigned_at"`
+}
+
+// AssignTeamRoleRequest gives a user a role in a team, replacing any role they had
+type AssignTeamRoleRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	Role   string    `json:"role"` // manager, captain, vice_captain
+}
+
+// TransferOwnershipRequest hands a team over to another user
+type TransferOwnershipRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+}
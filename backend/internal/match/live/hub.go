@@ -0,0 +1,160 @@
+package live
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	// subscriberBuffer is how many events a follower may fall behind before
+	// its subscription is dropped
+	subscriberBuffer = 64
+	// idleFeedTTL is how long a match without followers or new events keeps
+	// its history before it is forgotten
+	idleFeedTTL   = time.Hour
+	sweepInterval = time.Minute
+)
+
+type subscriber struct {
+	events chan domain.MatchEvent
+}
+
+type matchFeed struct {
+	// epoch is new for every feed, so sequence numbers from before a restart
+	// or sweep are never mistaken for this feed's
+	epoch       string
+	sequence    int64
+	history     []domain.MatchEvent
+	subscribers map[*subscriber]struct{}
+	lastEventAt time.Time
+}
+
+type hub struct {
+	mu        sync.Mutex
+	history   int
+	feeds     map[uuid.UUID]*matchFeed
+	lastSweep time.Time
+}
+
+// NewHub returns an in-process broker that keeps the last history events of
+// each match so followers can resume after a reconnect. Events only reach
+// followers connected to the same process.
+func NewHub(history int) domain.EventBroker {
+	return &hub{
+		history:   history,
+		feeds:     make(map[uuid.UUID]*matchFeed),
+		lastSweep: time.Now(),
+	}
+}
+
+func (h *hub) Publish(ctx context.Context, matchID uuid.UUID, eventType string, data interface{}) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.sweep()
+
+	feed := h.feed(matchID)
+	feed.sequence++
+	feed.lastEventAt = time.Now()
+
+	event := domain.MatchEvent{
+		Epoch:     feed.epoch,
+		Sequence:  feed.sequence,
+		MatchID:   matchID,
+		Type:      eventType,
+		Data:      data,
+		CreatedAt: feed.lastEventAt,
+	}
+
+	feed.history = append(feed.history, event)
+	if len(feed.history) > h.history {
+		feed.history = feed.history[len(feed.history)-h.history:]
+	}
+
+	for sub := range feed.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			// Too far behind; the follower reconnects and resumes or re-syncs
+			delete(feed.subscribers, sub)
+			close(sub.events)
+		}
+	}
+
+	return nil
+}
+
+func (h *hub) Subscribe(ctx context.Context, matchID uuid.UUID, epoch string, afterSequence int64) (*domain.Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.sweep()
+
+	feed := h.feed(matchID)
+	sub := &subscriber{events: make(chan domain.MatchEvent, h.history+subscriberBuffer)}
+
+	// Replay what the follower missed if the history still covers it
+	resumed := false
+	if epoch == feed.epoch && afterSequence > 0 && afterSequence <= feed.sequence {
+		if afterSequence == feed.sequence {
+			resumed = true
+		} else if len(feed.history) > 0 && feed.history[0].Sequence <= afterSequence+1 {
+			resumed = true
+			for _, event := range feed.history {
+				if event.Sequence > afterSequence {
+					sub.events <- event
+				}
+			}
+		}
+	}
+
+	feed.subscribers[sub] = struct{}{}
+
+	return &domain.Subscription{
+		Events:       sub.events,
+		Epoch:        feed.epoch,
+		LastSequence: feed.sequence,
+		Resumed:      resumed,
+		Close: func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			if _, ok := feed.subscribers[sub]; ok {
+				delete(feed.subscribers, sub)
+				close(sub.events)
+			}
+		},
+	}, nil
+}
+
+// feed returns the feed of a match, creating it on first use. Callers hold h.mu.
+func (h *hub) feed(matchID uuid.UUID) *matchFeed {
+	feed, ok := h.feeds[matchID]
+	if !ok {
+		feed = &matchFeed{
+			epoch:       uuid.NewString(),
+			subscribers: make(map[*subscriber]struct{}),
+			lastEventAt: time.Now(),
+		}
+		h.feeds[matchID] = feed
+	}
+	return feed
+}
+
+// sweep forgets matches nobody is following that have gone quiet. Callers hold h.mu.
+func (h *hub) sweep() {
+	now := time.Now()
+	if now.Sub(h.lastSweep) < sweepInterval {
+		return
+	}
+	h.lastSweep = now
+
+	for matchID, feed := range h.feeds {
+		if len(feed.subscribers) == 0 && now.Sub(feed.lastEventAt) > idleFeedTTL {
+			delete(h.feeds, matchID)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+)
+
+// Delivery operations
+
+// lockMatchScoring locks a match row so deliveries of the match are recorded
+// and undone one at a time
+const lockMatchScoring = `SELECT id FROM matches WHERE id = $1 FOR UPDATE`
+
+// CreateDelivery records a delivery at the sequence it was validated for. The
+// sequence is checked and the delivery inserted under the match lock, so a
+// ball recorded or undone in between is reported instead of overwritten.
+func (r *matchRepository) CreateDelivery(ctx context.Context, delivery *domain.Delivery) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, lockMatchScoring, delivery.MatchID); err != nil {
+		return fmt.Errorf("failed to lock match: %w", err)
+	}
+
+	var next int
+	err = tx.QueryRowContext(ctx,
+		`SELECT COALESCE(MAX(sequence), 0) + 1 FROM match_deliveries WHERE match_id = $1`,
+		delivery.MatchID,
+	).Scan(&next)
+	if err != nil {
+		return err
+	}
+	if next != delivery.Sequence {
+		return fmt.Errorf("another delivery was recorded at the same time, please retry")
+	}
+
+	query := `
+		INSERT INTO match_deliveries (
+			id, match_id, innings, sequence, over_number, ball_number,
+			batting_team_id, bowling_team_id, striker_id, non_striker_id, bowler_id,
+			runs_off_bat, extras, extra_type,
+			is_wicket, wicket_type, dismissed_player_id, fielder_id, created_by
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+		RETURNING created_at
+	`
+	err = tx.QueryRowContext(ctx, query,
+		delivery.ID, delivery.MatchID, delivery.Innings, delivery.Sequence,
+		delivery.OverNumber, delivery.BallNumber,
+		delivery.BattingTeamID, delivery.BowlingTeamID,
+		delivery.StrikerID, delivery.NonStrikerID, delivery.BowlerID,
+		delivery.RunsOffBat, delivery.Extras, delivery.ExtraType,
+		delivery.IsWicket, delivery.WicketType, delivery.DismissedPlayerID, delivery.FielderID,
+		delivery.CreatedBy,
+	).Scan(&delivery.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *matchRepository) ListDeliveries(ctx context.Context, matchID uuid.UUID, innings *int) ([]domain.Delivery, error) {
+	query := `
+		SELECT id, match_id, innings, sequence, over_number, ball_number,
+		       batting_team_id, bowling_team_id, striker_id, non_striker_id, bowler_id,
+		       runs_off_bat, extras, extra_type,
+		       is_wicket, wicket_type, dismissed_player_id, fielder_id,
+		       created_by, created_at
+		FROM match_deliveries
+		WHERE match_id = $1
+		  AND ($2::int IS NULL OR innings = $2)
+		ORDER BY sequence
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, matchID, innings)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []domain.Delivery
+	for rows.Next() {
+		var d domain.Delivery
+		if err := scanDelivery(rows, &d); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// UndoLastDelivery removes the latest delivery of a match under the match
+// lock. An innings started by its first delivery goes with it, so it can be
+// restarted with the other side batting.
+func (r *matchRepository) UndoLastDelivery(ctx context.Context, matchID uuid.UUID) (*domain.Delivery, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, lockMatchScoring, matchID); err != nil {
+		return nil, fmt.Errorf("failed to lock match: %w", err)
+	}
+
+	query := `
+		SELECT id, match_id, innings, sequence, over_number, ball_number,
+		       batting_team_id, bowling_team_id, striker_id, non_striker_id, bowler_id,
+		       runs_off_bat, extras, extra_type,
+		       is_wicket, wicket_type, dismissed_player_id, fielder_id,
+		       created_by, created_at
+		FROM match_deliveries
+		WHERE match_id = $1
+		ORDER BY sequence DESC
+		LIMIT 1
+	`
+
+	d := &domain.Delivery{}
+	err = scanDelivery(tx.QueryRowContext(ctx, query, matchID), d)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("no deliveries recorded")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM match_deliveries WHERE id = $1`, d.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete delivery: %w", err)
+	}
+
+	if d.Innings <= 2 {
+		_, err := tx.ExecContext(ctx, `
+			DELETE FROM match_innings i
+			WHERE i.match_id = $1 AND i.innings_number = $2
+			  AND NOT EXISTS (
+			      SELECT 1 FROM match_deliveries d
+			      WHERE d.match_id = i.match_id AND d.innings = i.innings_number
+			  )
+		`, matchID, d.Innings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete empty innings: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDelivery(row rowScanner, d *domain.Delivery) error {
+	return row.Scan(
+		&d.ID, &d.MatchID, &d.Innings, &d.Sequence, &d.OverNumber, &d.BallNumber,
+		&d.BattingTeamID, &d.BowlingTeamID, &d.StrikerID, &d.NonStrikerID, &d.BowlerID,
+		&d.RunsOffBat, &d.Extras, &d.ExtraType,
+		&d.IsWicket, &d.WicketType, &d.DismissedPlayerID, &d.FielderID,
+		&d.CreatedBy, &d.CreatedAt,
+	)
+}
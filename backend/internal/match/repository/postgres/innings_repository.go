@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Innings operations
+
+func (r *matchRepository) CreateInnings(ctx context.Context, innings *domain.Innings) error {
+	query := `
+		INSERT INTO match_innings (
+			id, match_id, innings_number, batting_team_id, bowling_team_id,
+			is_super_over, follow_on, declared, max_overs, max_wickets, target, started_by
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING started_at
+	`
+	err := r.db.QueryRowContext(ctx, query,
+		innings.ID, innings.MatchID, innings.Number, innings.BattingTeamID, innings.BowlingTeamID,
+		innings.IsSuperOver, innings.FollowOn, innings.Declared, innings.MaxOvers, innings.MaxWickets,
+		innings.Target, innings.StartedBy,
+	).Scan(&innings.StartedAt)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("innings %d has already started", innings.Number)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *matchRepository) ListInnings(ctx context.Context, matchID uuid.UUID) ([]domain.Innings, error) {
+	query := `
+		SELECT id, match_id, innings_number, batting_team_id, bowling_team_id,
+		       is_super_over, follow_on, declared, max_overs, revised_overs, max_wickets, target,
+		       started_by, started_at
+		FROM match_innings
+		WHERE match_id = $1
+		ORDER BY innings_number
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var innings []domain.Innings
+	for rows.Next() {
+		var i domain.Innings
+		err := rows.Scan(
+			&i.ID, &i.MatchID, &i.Number, &i.BattingTeamID, &i.BowlingTeamID,
+			&i.IsSuperOver, &i.FollowOn, &i.Declared, &i.MaxOvers, &i.RevisedOvers, &i.MaxWickets, &i.Target,
+			&i.StartedBy, &i.StartedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		innings = append(innings, i)
+	}
+
+	return innings, rows.Err()
+}
+
+func (r *matchRepository) DeclareInnings(ctx context.Context, matchID uuid.UUID, number int) error {
+	query := `
+		UPDATE match_innings SET declared = true
+		WHERE match_id = $1 AND innings_number = $2 AND declared = false
+	`
+	result, err := r.db.ExecContext(ctx, query, matchID, number)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("innings %d has already been declared", number)
+	}
+
+	return nil
+}
+
+func (r *matchRepository) ReviseInnings(ctx context.Context, matchID uuid.UUID, number, overs int) error {
+	query := `
+		UPDATE match_innings SET revised_overs = $3
+		WHERE match_id = $1 AND innings_number = $2
+	`
+	result, err := r.db.ExecContext(ctx, query, matchID, number, overs)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("innings %d has not started", number)
+	}
+
+	return nil
+}
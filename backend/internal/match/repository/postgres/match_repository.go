@@ -24,16 +24,35 @@ func NewMatchRepository(db *sql.DB) domain.MatchRepository {
 
 // Team operations
 
+// CreateTeam stores a team and makes its creator the owner
 func (r *matchRepository) CreateTeam(ctx context.Context, team *domain.Team) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	query := `
 		INSERT INTO teams (id, name, short_name, logo_url, colors, created_by, captain_id, description, home_ground)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
-	_, err := r.db.ExecContext(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		team.ID, team.Name, team.ShortName, team.LogoURL, pq.Array(team.Colors),
 		team.CreatedBy, team.CaptainID, team.Description, team.HomeGround,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO team_roles (team_id, user_id, role, assigned_by) VALUES ($1, $2, $3, $2)",
+		team.ID, team.CreatedBy, domain.TeamRoleOwner,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (r *matchRepository) GetTeamByID(ctx context.Context, teamID uuid.UUID) (*domain.Team, error) {
@@ -113,7 +132,7 @@ func (r *matchRepository) GetTeamStats(ctx context.Context, teamID uuid.UUID) (*
 			COUNT(*) as total_matches,
 			COUNT(CASE WHEN (result->>'winner_team_id')::uuid = $1 THEN 1 END) as wins,
 			COUNT(CASE WHEN result->>'winner_team_id' IS NOT NULL AND (result->>'winner_team_id')::uuid != $1 THEN 1 END) as losses,
-			COUNT(CASE WHEN result->>'result_type' IN ('tie', 'no-result') THEN 1 END) as draws
+			COUNT(CASE WHEN result->>'result_type' IN ('tie', 'draw', 'no-result') THEN 1 END) as draws
 		FROM matches
 		WHERE (team_a_id = $1 OR team_b_id = $1) AND status = 'completed'
 	`
@@ -433,10 +452,28 @@ func (r *matchRepository) GetMatchByID(ctx context.Context, matchID uuid.UUID) (
 	if resType, ok := result["result_type"].(string); ok {
 		match.ResultType = &resType
 	}
+	match.MarginRuns = resultInt(result, "margin_runs")
+	match.MarginWickets = resultInt(result, "margin_wickets")
+	match.BallsRemaining = resultInt(result, "balls_remaining")
+	match.Target = resultInt(result, "target")
+	match.RevisedOvers = resultInt(result, "revised_overs")
+	if reason, ok := result["override_reason"].(string); ok {
+		match.OverrideReason = &reason
+	}
 
 	return match, nil
 }
 
+// resultInt reads a whole number from the decoded result JSON
+func resultInt(result map[string]interface{}, key string) *int {
+	v, ok := result[key].(float64)
+	if !ok {
+		return nil
+	}
+	n := int(v)
+	return &n
+}
+
 func (r *matchRepository) ListMatches(ctx context.Context, filters domain.MatchFilters) ([]domain.Match, int, error) {
 	// Build WHERE clause
 	var conditions []string
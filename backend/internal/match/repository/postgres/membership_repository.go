@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Membership operations
+
+// membershipColumns selects a membership request with its team and user names,
+// in the order read by scanMembershipRequest
+const membershipColumns = `
+	m.id, m.team_id, t.name, m.user_id, u.full_name, m.kind, m.status, m.requested_by,
+	m.jersey_number, m.role, m.batting, m.bowling, m.message,
+	m.responded_by, m.responded_at, m.player_id, m.created_at, m.updated_at`
+
+const membershipFrom = `
+	FROM team_membership_requests m
+	JOIN teams t ON t.id = m.team_id
+	JOIN users u ON u.id = m.user_id`
+
+func scanMembershipRequest(row rowScanner, req *domain.MembershipRequest) error {
+	return row.Scan(
+		&req.ID, &req.TeamID, &req.TeamName, &req.UserID, &req.UserName, &req.Kind, &req.Status, &req.RequestedBy,
+		&req.JerseyNumber, &req.Role, &req.Batting, &req.Bowling, &req.Message,
+		&req.RespondedBy, &req.RespondedAt, &req.PlayerID, &req.CreatedAt, &req.UpdatedAt,
+	)
+}
+
+func (r *matchRepository) CreateMembershipRequest(ctx context.Context, req *domain.MembershipRequest) error {
+	query := `
+		INSERT INTO team_membership_requests (
+			id, team_id, user_id, kind, status, requested_by,
+			jersey_number, role, batting, bowling, message
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING created_at, updated_at
+	`
+	err := r.db.QueryRowContext(ctx, query,
+		req.ID, req.TeamID, req.UserID, req.Kind, req.Status, req.RequestedBy,
+		req.JerseyNumber, req.Role, req.Batting, req.Bowling, req.Message,
+	).Scan(&req.CreatedAt, &req.UpdatedAt)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("there is already a pending invitation or join request for this user and team")
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *matchRepository) GetMembershipRequest(ctx context.Context, requestID uuid.UUID) (*domain.MembershipRequest, error) {
+	query := `SELECT ` + membershipColumns + membershipFrom + ` WHERE m.id = $1`
+
+	req := &domain.MembershipRequest{}
+	err := scanMembershipRequest(r.db.QueryRowContext(ctx, query, requestID), req)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("membership request not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func (r *matchRepository) ListMembershipRequests(ctx context.Context, filters domain.MembershipFilters) ([]domain.MembershipRequest, error) {
+	var conditions []string
+	var args []interface{}
+
+	if filters.TeamID != nil {
+		args = append(args, *filters.TeamID)
+		conditions = append(conditions, fmt.Sprintf("m.team_id = $%d", len(args)))
+	}
+	if filters.UserID != nil {
+		args = append(args, *filters.UserID)
+		conditions = append(conditions, fmt.Sprintf("m.user_id = $%d", len(args)))
+	}
+	if filters.Kind != nil {
+		args = append(args, *filters.Kind)
+		conditions = append(conditions, fmt.Sprintf("m.kind = $%d", len(args)))
+	}
+	if filters.Status != nil {
+		args = append(args, *filters.Status)
+		conditions = append(conditions, fmt.Sprintf("m.status = $%d", len(args)))
+	}
+
+	query := `SELECT ` + membershipColumns + membershipFrom
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY m.created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests := []domain.MembershipRequest{}
+	for rows.Next() {
+		var req domain.MembershipRequest
+		if err := scanMembershipRequest(rows, &req); err != nil {
+			return nil, err
+		}
+		requests = append(requests, req)
+	}
+
+	return requests, rows.Err()
+}
+
+func (r *matchRepository) IsActiveTeamMember(ctx context.Context, teamID, userID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM players WHERE team_id = $1 AND user_id = $2 AND is_active = true)`
+
+	var member bool
+	err := r.db.QueryRowContext(ctx, query, teamID, userID).Scan(&member)
+	return member, err
+}
+
+func (r *matchRepository) AcceptMembershipRequest(ctx context.Context, req *domain.MembershipRequest, respondedBy uuid.UUID) (*domain.Player, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// A player who was removed from the team earlier is reactivated, keeping
+	// their career stats
+	player := &domain.Player{
+		UserID:       req.UserID,
+		TeamID:       req.TeamID,
+		JerseyNumber: req.JerseyNumber,
+		Role:         req.Role,
+		Batting:      req.Batting,
+		Bowling:      req.Bowling,
+		IsActive:     true,
+	}
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO players (id, user_id, team_id, jersey_number, role, batting, bowling)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, team_id) DO UPDATE SET
+			jersey_number = EXCLUDED.jersey_number, role = EXCLUDED.role,
+			batting = EXCLUDED.batting, bowling = EXCLUDED.bowling,
+			is_active = true, joined_at = CURRENT_TIMESTAMP
+		WHERE players.is_active = false
+		RETURNING id, joined_at
+	`, uuid.New(), player.UserID, player.TeamID, player.JerseyNumber, player.Role, player.Batting, player.Bowling,
+	).Scan(&player.ID, &player.JoinedAt)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("user is already a player in this team")
+	}
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return nil, fmt.Errorf("jersey number %d is already taken in this team", req.JerseyNumber)
+		}
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE team_membership_requests
+		SET status = $1, responded_by = $2, responded_at = CURRENT_TIMESTAMP,
+		    player_id = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4 AND status = $5
+	`, domain.MembershipAccepted, respondedBy, player.ID, req.ID, domain.MembershipPending)
+	if err != nil {
+		return nil, err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return nil, fmt.Errorf("%s is no longer pending", strings.ReplaceAll(req.Kind, "_", " "))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return player, nil
+}
+
+func (r *matchRepository) CloseMembershipRequest(ctx context.Context, requestID uuid.UUID, status string, respondedBy uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE team_membership_requests
+		SET status = $1, responded_by = $2, responded_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3 AND status = $4
+	`, status, respondedBy, requestID, domain.MembershipPending)
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("request is no longer pending")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Rules operations
+
+func (r *matchRepository) GetTournamentRules(ctx context.Context, matchID uuid.UUID) (map[string]interface{}, error) {
+	query := `
+		SELECT t.rules
+		FROM tournament_matches tm
+		JOIN tournaments t ON t.id = tm.tournament_id
+		WHERE tm.match_id = $1
+		ORDER BY tm.created_at
+		LIMIT 1
+	`
+
+	var rulesJSON []byte
+	err := r.db.QueryRowContext(ctx, query, matchID).Scan(&rulesJSON)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rules map[string]interface{}
+	if len(rulesJSON) > 0 {
+		if err := json.Unmarshal(rulesJSON, &rules); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal tournament rules: %w", err)
+		}
+	}
+
+	return rules, nil
+}
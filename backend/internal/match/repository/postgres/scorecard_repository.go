@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Scorecard operations
+
+func (r *matchRepository) GetMatchPlayerNames(ctx context.Context, matchID uuid.UUID) (map[uuid.UUID]string, error) {
+	query := `
+		SELECT p.id, u.full_name
+		FROM matches m
+		JOIN players p ON p.team_id IN (m.team_a_id, m.team_b_id)
+		JOIN users u ON u.id = p.user_id
+		WHERE m.id = $1
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := make(map[uuid.UUID]string)
+	for rows.Next() {
+		var id uuid.UUID
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		names[id] = name
+	}
+
+	return names, rows.Err()
+}
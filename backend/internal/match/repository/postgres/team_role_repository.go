@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+)
+
+// Team role operations
+
+func (r *matchRepository) GetTeamRole(ctx context.Context, teamID, userID uuid.UUID) (string, error) {
+	var role string
+	err := r.db.QueryRowContext(ctx,
+		"SELECT role FROM team_roles WHERE team_id = $1 AND user_id = $2", teamID, userID,
+	).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return role, err
+}
+
+func (r *matchRepository) ListTeamRoles(ctx context.Context, teamID uuid.UUID) ([]domain.TeamRole, error) {
+	query := `
+		SELECT tr.team_id, tr.user_id, u.full_name, tr.role, tr.assigned_by, tr.assigned_at
+		FROM team_roles tr
+		JOIN users u ON u.id = tr.user_id
+		WHERE tr.team_id = $1
+		ORDER BY CASE tr.role
+			WHEN 'owner' THEN 1 WHEN 'manager' THEN 2 WHEN 'captain' THEN 3 ELSE 4
+		END, u.full_name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := []domain.TeamRole{}
+	for rows.Next() {
+		var role domain.TeamRole
+		err := rows.Scan(&role.TeamID, &role.UserID, &role.UserName, &role.Role, &role.AssignedBy, &role.AssignedAt)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, rows.Err()
+}
+
+// AssignTeamRole gives a user a role, replacing the role they had. Whoever
+// held a captain or vice-captain role before loses it.
+func (r *matchRepository) AssignTeamRole(ctx context.Context, role *domain.TeamRole) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if role.Role == domain.TeamRoleCaptain || role.Role == domain.TeamRoleViceCaptain {
+		_, err = tx.ExecContext(ctx,
+			"DELETE FROM team_roles WHERE team_id = $1 AND role = $2 AND user_id <> $3",
+			role.TeamID, role.Role, role.UserID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO team_roles (team_id, user_id, role, assigned_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (team_id, user_id) DO UPDATE SET
+			role = EXCLUDED.role, assigned_by = EXCLUDED.assigned_by, assigned_at = CURRENT_TIMESTAMP
+		RETURNING assigned_at
+	`, role.TeamID, role.UserID, role.Role, role.AssignedBy).Scan(&role.AssignedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := syncCaptain(ctx, tx, role.TeamID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *matchRepository) RemoveTeamRole(ctx context.Context, teamID, userID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM team_roles WHERE team_id = $1 AND user_id = $2", teamID, userID)
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("user has no role in this team")
+	}
+
+	if err := syncCaptain(ctx, tx, teamID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// TransferTeamOwnership makes newOwnerID the owner, replacing any role they
+// had, and keeps the previous owner on as a manager
+func (r *matchRepository) TransferTeamOwnership(ctx context.Context, teamID, ownerID, newOwnerID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		"UPDATE team_roles SET role = $1, assigned_by = $2, assigned_at = CURRENT_TIMESTAMP WHERE team_id = $3 AND user_id = $2 AND role = $4",
+		domain.TeamRoleManager, ownerID, teamID, domain.TeamRoleOwner,
+	)
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("only the team owner can transfer ownership")
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO team_roles (team_id, user_id, role, assigned_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (team_id, user_id) DO UPDATE SET
+			role = EXCLUDED.role, assigned_by = EXCLUDED.assigned_by, assigned_at = CURRENT_TIMESTAMP
+	`, teamID, newOwnerID, domain.TeamRoleOwner, ownerID)
+	if err != nil {
+		return err
+	}
+
+	if err := syncCaptain(ctx, tx, teamID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// syncCaptain copies the captain role to teams.captain_id. Each user holds one
+// role per team, so with no captain appointed the owner captains the team
+// when they play for it.
+func syncCaptain(ctx context.Context, tx *sql.Tx, teamID uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE teams SET captain_id = COALESCE(
+			(SELECT user_id FROM team_roles WHERE team_id = $1 AND role = 'captain'),
+			`+ownerCaptain+`
+		)
+		WHERE id = $1
+	`, teamID)
+	return err
+}
+
+// ownerCaptain selects the owner of team $1 if they are an active player in it
+const ownerCaptain = `(
+	SELECT tr.user_id FROM team_roles tr
+	JOIN players p ON p.team_id = tr.team_id AND p.user_id = tr.user_id AND p.is_active = true
+	WHERE tr.team_id = $1 AND tr.role = 'owner'
+)`
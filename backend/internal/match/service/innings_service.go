@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxMultiDayInnings is the number of innings in a multi-day match
+	maxMultiDayInnings = 4
+	// superOverWickets ends a super over innings
+	superOverWickets = 2
+)
+
+// Innings operations
+
+func (s *matchService) StartInnings(ctx context.Context, matchID uuid.UUID, req domain.StartInningsRequest, userID uuid.UUID) (*domain.Innings, error) {
+	match, err := s.scorableMatch(ctx, matchID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	innings, deliveries, err := s.loadInnings(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.matchRules(ctx, match)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := s.nextInnings(match, rules, innings, summarizeInnings(match, innings, deliveries), req, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateInnings(ctx, next); err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, matchID, domain.EventInnings, next)
+
+	return next, nil
+}
+
+func (s *matchService) ListInnings(ctx context.Context, matchID uuid.UUID) ([]domain.Innings, error) {
+	innings, err := s.repo.ListInnings(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if innings == nil {
+		innings = []domain.Innings{}
+	}
+	return innings, nil
+}
+
+func (s *matchService) DeclareInnings(ctx context.Context, matchID uuid.UUID, number int, userID uuid.UUID) (*domain.Innings, error) {
+	match, err := s.scorableMatch(ctx, matchID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if isLimitedOvers(match) {
+		return nil, fmt.Errorf("innings can only be declared in multi-day matches")
+	}
+
+	innings, deliveries, err := s.loadInnings(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if number < 1 || number > len(innings) {
+		return nil, fmt.Errorf("innings %d has not started", number)
+	}
+	if number != len(innings) {
+		return nil, fmt.Errorf("innings %d has already finished", number)
+	}
+	for _, score := range summarizeInnings(match, innings, deliveries) {
+		if score.Innings == number && score.IsComplete {
+			return nil, fmt.Errorf("innings %d is complete", number)
+		}
+	}
+
+	if err := s.repo.DeclareInnings(ctx, matchID, number); err != nil {
+		return nil, err
+	}
+
+	declared := innings[number-1]
+	declared.Declared = true
+
+	s.publish(ctx, matchID, domain.EventInnings, &declared)
+
+	return &declared, nil
+}
+
+// ReviseInnings shortens the current innings of a limited-overs match after an
+// interruption. A shortened chase has its target scaled to the revised overs.
+func (s *matchService) ReviseInnings(ctx context.Context, matchID uuid.UUID, number int, req domain.ReviseInningsRequest, userID uuid.UUID) (*domain.Innings, error) {
+	match, err := s.scorableMatch(ctx, matchID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isLimitedOvers(match) {
+		return nil, fmt.Errorf("overs can only be revised in limited-overs matches")
+	}
+
+	innings, deliveries, err := s.loadInnings(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if number < 1 || number > len(innings) {
+		return nil, fmt.Errorf("innings %d has not started", number)
+	}
+	if number != len(innings) {
+		return nil, fmt.Errorf("innings %d has already finished", number)
+	}
+
+	revised := innings[number-1]
+	if revised.IsSuperOver || revised.MaxOvers == nil {
+		return nil, fmt.Errorf("innings %d cannot be shortened", number)
+	}
+	if req.Overs < 1 || req.Overs >= *revised.MaxOvers {
+		return nil, fmt.Errorf("revised overs must be between 1 and %d", *revised.MaxOvers-1)
+	}
+	for _, score := range summarizeInnings(match, innings, deliveries) {
+		if score.Innings != number {
+			continue
+		}
+		if score.IsComplete {
+			return nil, fmt.Errorf("innings %d is complete", number)
+		}
+		if score.LegalBalls > req.Overs*6 {
+			return nil, fmt.Errorf("innings %d has already lasted %s overs", number, score.Overs)
+		}
+	}
+
+	if err := s.repo.ReviseInnings(ctx, matchID, number, req.Overs); err != nil {
+		return nil, err
+	}
+
+	revised.RevisedOvers = &req.Overs
+
+	s.publish(ctx, matchID, domain.EventInnings, &revised)
+
+	return &revised, nil
+}
+
+// scorableMatch loads a live match the user is allowed to score
+func (s *matchService) scorableMatch(ctx context.Context, matchID, userID uuid.UUID) (*domain.Match, error) {
+	match, err := s.repo.GetMatchByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if match.CreatedBy != userID {
+		return nil, fmt.Errorf("not authorized to score this match")
+	}
+
+	if match.Status != "live" {
+		return nil, fmt.Errorf("innings can only be changed in live matches")
+	}
+
+	return match, nil
+}
+
+// nextInnings works out the innings that follows those already started:
+// who bats, what limits apply and the target, if any. An innings is all out
+// when one player of the side is left.
+func (s *matchService) nextInnings(match *domain.Match, rules *domain.MatchRules, innings []domain.Innings, scores []domain.InningsScore, req domain.StartInningsRequest, userID uuid.UUID) (*domain.Innings, error) {
+	number := len(innings) + 1
+	next := &domain.Innings{
+		ID:         uuid.New(),
+		MatchID:    match.ID,
+		Number:     number,
+		MaxWickets: rules.PlayersPerSide - 1,
+		FollowOn:   req.FollowOn,
+		StartedBy:  &userID,
+	}
+
+	var previous *domain.InningsScore
+	if len(scores) > 0 {
+		previous = &scores[len(scores)-1]
+	}
+
+	var err error
+	if isLimitedOvers(match) {
+		err = planLimitedOversInnings(match, next, scores, previous, req)
+	} else {
+		err = planMultiDayInnings(match, rules, next, scores, previous, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// A side named by the caller must be the one due to bat
+	if req.BattingTeamID != uuid.Nil && req.BattingTeamID != next.BattingTeamID {
+		return nil, fmt.Errorf("the other team is due to bat in innings %d", number)
+	}
+
+	return next, nil
+}
+
+func planLimitedOversInnings(match *domain.Match, next *domain.Innings, scores []domain.InningsScore, previous *domain.InningsScore, req domain.StartInningsRequest) error {
+	if req.FollowOn {
+		return fmt.Errorf("the follow-on only applies to multi-day matches")
+	}
+
+	if next.Number <= 2 {
+		if req.SuperOver {
+			return fmt.Errorf("a super over can only follow a tied match")
+		}
+		if match.TotalOvers > 0 {
+			overs := match.TotalOvers
+			next.MaxOvers = &overs
+		}
+		if next.Number == 1 {
+			return openingSide(match, next, req)
+		}
+		if !previous.IsComplete {
+			return fmt.Errorf("innings %d is not complete", previous.Innings)
+		}
+		// The chase is as long as the first innings, which may have been shortened
+		if previous.MaxOvers != nil {
+			overs := *previous.MaxOvers
+			next.MaxOvers = &overs
+		}
+		next.BattingTeamID, next.BowlingTeamID = previous.BowlingTeamID, previous.BattingTeamID
+		target := previous.Runs + 1
+		next.Target = &target
+		return nil
+	}
+
+	if !req.SuperOver {
+		return fmt.Errorf("a %s match has at most 2 innings besides super overs", match.MatchFormat)
+	}
+	if !previous.IsComplete {
+		return fmt.Errorf("innings %d is not complete", previous.Innings)
+	}
+
+	overs := 1
+	next.IsSuperOver = true
+	next.MaxOvers = &overs
+	next.MaxWickets = superOverWickets
+
+	// Second half of a super over: the other side chases
+	if next.Number%2 == 0 {
+		next.BattingTeamID, next.BowlingTeamID = previous.BowlingTeamID, previous.BattingTeamID
+		target := previous.Runs + 1
+		next.Target = &target
+		return nil
+	}
+
+	// A new super over needs the regular innings, or the last super over, tied
+	pair := scores[len(scores)-2:]
+	target := pair[0].Runs + 1
+	if pair[1].Target != nil {
+		target = *pair[1].Target
+	}
+	result := chaseResult(pair[0], pair[1], target, pair[1].MaxOvers)
+	if result == nil || result.ResultType != domain.ResultTie {
+		return fmt.Errorf("a super over can only follow a tied match")
+	}
+
+	// The side that batted second bats first in the super over
+	next.BattingTeamID, next.BowlingTeamID = pair[1].BattingTeamID, pair[1].BowlingTeamID
+	return nil
+}
+
+func planMultiDayInnings(match *domain.Match, rules *domain.MatchRules, next *domain.Innings, scores []domain.InningsScore, previous *domain.InningsScore, req domain.StartInningsRequest) error {
+	if req.SuperOver {
+		return fmt.Errorf("super overs only apply to limited-overs matches")
+	}
+	if next.Number > maxMultiDayInnings {
+		return fmt.Errorf("a multi-day match has at most %d innings", maxMultiDayInnings)
+	}
+	if previous != nil && !previous.IsComplete {
+		return fmt.Errorf("innings %d must be completed or declared first", previous.Innings)
+	}
+	if req.FollowOn && next.Number != 3 {
+		return fmt.Errorf("the follow-on can only be enforced for the third innings")
+	}
+
+	switch next.Number {
+	case 1:
+		return openingSide(match, next, req)
+	case 2:
+		next.BattingTeamID, next.BowlingTeamID = previous.BowlingTeamID, previous.BattingTeamID
+	case 3:
+		if req.FollowOn {
+			if scores[0].Runs-scores[1].Runs < rules.FollowOnMargin {
+				return fmt.Errorf("the follow-on needs a first innings lead of at least %d runs", rules.FollowOnMargin)
+			}
+			next.BattingTeamID, next.BowlingTeamID = previous.BattingTeamID, previous.BowlingTeamID
+		} else {
+			next.BattingTeamID, next.BowlingTeamID = previous.BowlingTeamID, previous.BattingTeamID
+		}
+	case 4:
+		next.BattingTeamID, next.BowlingTeamID = previous.BowlingTeamID, previous.BattingTeamID
+
+		totals := make(map[uuid.UUID]int)
+		for _, score := range scores {
+			totals[score.BattingTeamID] += score.Runs
+		}
+		target := totals[next.BowlingTeamID] - totals[next.BattingTeamID] + 1
+		if target <= 0 {
+			return fmt.Errorf("the match has already been decided")
+		}
+		next.Target = &target
+	}
+
+	return nil
+}
+
+// openingSide sets the sides for the first innings from the team named to bat
+func openingSide(match *domain.Match, next *domain.Innings, req domain.StartInningsRequest) error {
+	switch req.BattingTeamID {
+	case match.TeamAID:
+		next.BattingTeamID, next.BowlingTeamID = match.TeamAID, match.TeamBID
+	case match.TeamBID:
+		next.BattingTeamID, next.BowlingTeamID = match.TeamBID, match.TeamAID
+	default:
+		return fmt.Errorf("batting team is not part of this match")
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+)
+
+// Live updates
+
+func (s *matchService) FollowMatch(ctx context.Context, matchID uuid.UUID, epoch string, lastSequence int64) (*domain.LiveFeed, error) {
+	if s.broker == nil {
+		return nil, fmt.Errorf("live updates are not available")
+	}
+
+	match, err := s.repo.GetMatchByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("match not found")
+	}
+
+	// Subscribe before reading the snapshot so nothing published in between is lost
+	sub, err := s.broker.Subscribe(ctx, matchID, epoch, lastSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &domain.LiveFeed{Subscription: sub}
+	if sub.Resumed {
+		return feed, nil
+	}
+
+	innings, deliveries, err := s.loadInnings(ctx, matchID)
+	if err != nil {
+		sub.Close()
+		return nil, err
+	}
+
+	squad, err := s.repo.GetMatchSquad(ctx, matchID)
+	if err != nil {
+		sub.Close()
+		return nil, err
+	}
+
+	feed.Snapshot = &domain.LiveSnapshot{
+		Match: match,
+		Score: buildLiveScore(match, innings, deliveries),
+		Squad: squad,
+	}
+
+	return feed, nil
+}
+
+// publish pushes an event to live followers. The change is already persisted,
+// so a broker failure is logged rather than returned.
+func (s *matchService) publish(ctx context.Context, matchID uuid.UUID, eventType string, data interface{}) {
+	if s.broker == nil {
+		return
+	}
+
+	if err := s.broker.Publish(ctx, matchID, eventType, data); err != nil {
+		log.Printf("publishing %s event for match %s failed: %v", eventType, matchID, err)
+	}
+}
+
+// publishDelivery pushes a recorded or undone delivery with the score after it
+func (s *matchService) publishDelivery(ctx context.Context, match *domain.Match, eventType string, delivery *domain.Delivery) {
+	if s.broker == nil {
+		return
+	}
+
+	event := &domain.DeliveryEvent{Delivery: delivery}
+	if innings, deliveries, err := s.loadInnings(ctx, match.ID); err == nil {
+		event.Score = buildLiveScore(match, innings, deliveries)
+	} else {
+		log.Printf("building live score for match %s failed: %v", match.ID, err)
+	}
+
+	s.publish(ctx, match.ID, eventType, event)
+}
+
+// publishSquad pushes a change to a match squad
+func (s *matchService) publishSquad(ctx context.Context, matchID uuid.UUID, action string, teamID, playerID uuid.UUID, squad *domain.MatchSquad) {
+	s.publish(ctx, matchID, domain.EventSquad, &domain.SquadEvent{
+		Action:   action,
+		TeamID:   teamID,
+		PlayerID: playerID,
+		Squad:    squad,
+	})
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/cricketapp/backend/internal/match/domain"
@@ -10,12 +11,18 @@ import (
 )
 
 type matchService struct {
-	repo domain.MatchRepository
+	repo           domain.MatchRepository
+	fitnessCheck   domain.PlayerFitnessCheck
+	broker         domain.EventBroker
+	completedHooks []domain.MatchCompletedHook
 }
 
-// NewMatchService creates a new match service
-func NewMatchService(repo domain.MatchRepository) domain.MatchService {
-	return &matchService{repo: repo}
+// NewMatchService creates a new match service. fitnessCheck, when not nil, is
+// consulted each time a player is named in a playing 11. broker, when not nil,
+// receives live score, status and squad events. Hooks are run, in order, each
+// time a match is marked completed.
+func NewMatchService(repo domain.MatchRepository, fitnessCheck domain.PlayerFitnessCheck, broker domain.EventBroker, completedHooks ...domain.MatchCompletedHook) domain.MatchService {
+	return &matchService{repo: repo, fitnessCheck: fitnessCheck, broker: broker, completedHooks: completedHooks}
 }
 
 // Team operations
@@ -80,17 +87,17 @@ func (s *matchService) ListTeams(ctx context.Context, userID *uuid.UUID) (*domai
 }
 
 func (s *matchService) UpdateTeam(ctx context.Context, teamID uuid.UUID, req domain.CreateTeamRequest, userID uuid.UUID) (*domain.Team, error) {
+	// Check authorization
+	if _, err := s.requireTeamRole(ctx, teamID, userID, domain.TeamEditors, "update the team"); err != nil {
+		return nil, err
+	}
+
 	// Get existing team
 	team, err := s.repo.GetTeamByID(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check authorization
-	if team.CreatedBy != userID {
-		return nil, fmt.Errorf("not authorized to update this team")
-	}
-
 	// Update fields
 	team.Name = req.Name
 	team.ShortName = req.ShortName
@@ -108,15 +115,9 @@ func (s *matchService) UpdateTeam(ctx context.Context, teamID uuid.UUID, req dom
 }
 
 func (s *matchService) DeleteTeam(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error {
-	// Get team
-	team, err := s.repo.GetTeamByID(ctx, teamID)
-	if err != nil {
-		return err
-	}
-
 	// Check authorization
-	if team.CreatedBy != userID {
-		return fmt.Errorf("not authorized to delete this team")
+	if _, err := s.requireTeamRole(ctx, teamID, userID, []string{domain.TeamRoleOwner}, "delete the team"); err != nil {
+		return err
 	}
 
 	return s.repo.DeleteTeam(ctx, teamID)
@@ -126,26 +127,18 @@ func (s *matchService) DeleteTeam(ctx context.Context, teamID uuid.UUID, userID
 
 func (s *matchService) AddPlayer(ctx context.Context, req domain.CreatePlayerRequest, userID uuid.UUID) (*domain.Player, error) {
 	// Validate
-	if req.JerseyNumber < 1 || req.JerseyNumber > 99 {
-		return nil, fmt.Errorf("jersey number must be between 1 and 99")
-	}
-
-	validRoles := map[string]bool{
-		"batsman": true, "bowler": true, "all-rounder": true, "wicket-keeper": true,
-	}
-	if !validRoles[req.Role] {
-		return nil, fmt.Errorf("invalid player role")
+	if err := validatePlayerDetails(req.JerseyNumber, req.Role); err != nil {
+		return nil, err
 	}
 
-	// Check team exists
-	team, err := s.repo.GetTeamByID(ctx, req.TeamID)
-	if err != nil {
-		return nil, fmt.Errorf("team not found")
+	// Check authorization
+	if _, err := s.requireTeamRole(ctx, req.TeamID, userID, domain.TeamSelectors, "add players"); err != nil {
+		return nil, err
 	}
 
-	// Check authorization (only team creator can add players)
-	if team.CreatedBy != userID {
-		return nil, fmt.Errorf("not authorized to add players to this team")
+	// Anyone else has to agree to join, through an invitation
+	if req.UserID != userID {
+		return nil, fmt.Errorf("other users must be invited to join the team")
 	}
 
 	player := &domain.Player{
@@ -160,8 +153,7 @@ func (s *matchService) AddPlayer(ctx context.Context, req domain.CreatePlayerReq
 		JoinedAt:     time.Now(),
 	}
 
-	err = s.repo.AddPlayerToTeam(ctx, player)
-	if err != nil {
+	if err := s.repo.AddPlayerToTeam(ctx, player); err != nil {
 		return nil, err
 	}
 
@@ -203,16 +195,11 @@ func (s *matchService) RemovePlayer(ctx context.Context, playerID uuid.UUID, use
 		return err
 	}
 
-	// Get team to check authorization
-	team, err := s.repo.GetTeamByID(ctx, player.TeamID)
-	if err != nil {
+	// Check authorization
+	if _, err := s.requireTeamRole(ctx, player.TeamID, userID, domain.TeamSelectors, "remove players"); err != nil {
 		return err
 	}
 
-	if team.CreatedBy != userID {
-		return fmt.Errorf("not authorized to remove this player")
-	}
-
 	return s.repo.RemovePlayerFromTeam(ctx, playerID)
 }
 
@@ -235,19 +222,20 @@ func (s *matchService) CreateMatch(ctx context.Context, req domain.CreateMatchRe
 		return nil, fmt.Errorf("invalid match type")
 	}
 
-	validFormats := map[string]bool{
-		"T20": true, "ODI": true, "Test": true, "T10": true,
-	}
-	if !validFormats[req.MatchFormat] {
+	rules, ok := domain.FormatRules(req.MatchFormat)
+	if !ok {
 		return nil, fmt.Errorf("invalid match format")
 	}
 
 	// Validate overs based on format
-	expectedOvers := map[string]int{
-		"T10": 10, "T20": 20, "ODI": 50, "Test": 90,
-	}
 	if req.TotalOvers == 0 {
-		req.TotalOvers = expectedOvers[req.MatchFormat]
+		req.TotalOvers = rules.OversPerInnings
+		if req.TotalOvers == 0 {
+			req.TotalOvers = rules.OversPerDay
+		}
+	}
+	if err := checkOvers(&rules, req.TotalOvers); err != nil {
+		return nil, err
 	}
 
 	// Match date should not be in the past
@@ -266,6 +254,13 @@ func (s *matchService) CreateMatch(ctx context.Context, req domain.CreateMatchRe
 		return nil, fmt.Errorf("team B not found")
 	}
 
+	// Only someone running one of the teams can arrange a match
+	if _, err := s.requireTeamRole(ctx, req.TeamAID, userID, domain.TeamSelectors, "create matches"); err != nil {
+		if _, err := s.requireTeamRole(ctx, req.TeamBID, userID, domain.TeamSelectors, "create matches"); err != nil {
+			return nil, fmt.Errorf("only an owner, manager or captain of one of the teams can create this match")
+		}
+	}
+
 	match := &domain.Match{
 		ID:          uuid.New(),
 		Title:       req.Title,
@@ -356,6 +351,13 @@ func (s *matchService) UpdateMatch(ctx context.Context, matchID uuid.UUID, req d
 		match.VenueCity = *req.VenueCity
 	}
 	if req.TotalOvers != nil {
+		rules, err := s.matchRules(ctx, match)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkOvers(rules, *req.TotalOvers); err != nil {
+			return nil, err
+		}
 		match.TotalOvers = *req.TotalOvers
 	}
 	if req.Description != nil {
@@ -392,6 +394,11 @@ func (s *matchService) UpdateMatchStatus(ctx context.Context, matchID uuid.UUID,
 		return nil, fmt.Errorf("invalid status")
 	}
 
+	// Knockout fixtures are created before both teams are known
+	if (req.Status == "live" || req.Status == "completed") && (match.TeamAID == uuid.Nil || match.TeamBID == uuid.Nil) {
+		return nil, fmt.Errorf("match teams have not been decided yet")
+	}
+
 	// Build result object
 	result := make(map[string]interface{})
 
@@ -400,17 +407,21 @@ func (s *matchService) UpdateMatchStatus(ctx context.Context, matchID uuid.UUID,
 		if req.TossDecision != nil {
 			result["toss_decision"] = *req.TossDecision
 		}
+	} else if match.TossWonBy != nil {
+		// Keep the toss recorded when the match went live
+		result["toss_won_by"] = match.TossWonBy.String()
+		if match.TossDecision != nil {
+			result["toss_decision"] = *match.TossDecision
+		}
 	}
 
 	if req.Status == "completed" {
-		if req.WinnerTeamID != nil {
-			result["winner_team_id"] = req.WinnerTeamID.String()
-		}
-		if req.WinMargin != nil {
-			result["win_margin"] = *req.WinMargin
+		fields, err := s.completedResult(ctx, match, req)
+		if err != nil {
+			return nil, err
 		}
-		if req.ResultType != nil {
-			result["result_type"] = *req.ResultType
+		for key, value := range fields {
+			result[key] = value
 		}
 	}
 
@@ -419,7 +430,31 @@ func (s *matchService) UpdateMatchStatus(ctx context.Context, matchID uuid.UUID,
 		return nil, err
 	}
 
-	return s.repo.GetMatchByID(ctx, matchID)
+	updated, err := s.repo.GetMatchByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, matchID, domain.EventStatus, &domain.StatusEvent{
+		PreviousStatus: match.Status,
+		Match:          updated,
+	})
+
+	if updated.Status == "completed" {
+		s.runCompletedHooks(ctx, updated)
+	}
+
+	return updated, nil
+}
+
+// runCompletedHooks notifies listeners that a match has finished. The status
+// change is already persisted, so hook failures are logged rather than returned.
+func (s *matchService) runCompletedHooks(ctx context.Context, match *domain.Match) {
+	for _, hook := range s.completedHooks {
+		if err := hook(ctx, match); err != nil {
+			log.Printf("match %s completed hook failed: %v", match.ID, err)
+		}
+	}
 }
 
 func (s *matchService) DeleteMatch(ctx context.Context, matchID uuid.UUID, userID uuid.UUID) error {
@@ -451,16 +486,16 @@ func (s *matchService) AddPlayerToMatchSquad(ctx context.Context, matchID uuid.U
 		return nil, fmt.Errorf("match not found")
 	}
 
-	// Check authorization
-	if match.CreatedBy != userID {
-		return nil, fmt.Errorf("not authorized to manage squad")
-	}
-
 	// Validate team is in this match
 	if req.TeamID != match.TeamAID && req.TeamID != match.TeamBID {
 		return nil, fmt.Errorf("team is not part of this match")
 	}
 
+	// Check authorization
+	if err := s.canPickSquad(ctx, match, req.TeamID, userID); err != nil {
+		return nil, err
+	}
+
 	// Get player
 	player, err := s.repo.GetPlayerByID(ctx, req.PlayerID)
 	if err != nil {
@@ -472,18 +507,9 @@ func (s *matchService) AddPlayerToMatchSquad(ctx context.Context, matchID uuid.U
 		return nil, fmt.Errorf("player does not belong to this team")
 	}
 
-	// Check squad size if adding to playing 11
-	if req.InPlaying11 {
-		squad, _ := s.repo.GetTeamSquad(ctx, matchID, req.TeamID)
-		playing11Count := 0
-		for _, p := range squad {
-			if p.InPlaying11 {
-				playing11Count++
-			}
-		}
-		if playing11Count >= 11 {
-			return nil, fmt.Errorf("playing 11 is full")
-		}
+	// Check squad and playing 11 sizes
+	if err := s.checkSquadLimits(ctx, match, req.TeamID, req.PlayerID, req.InPlaying11); err != nil {
+		return nil, err
 	}
 
 	squadPlayer := &domain.MatchSquad{
@@ -503,6 +529,12 @@ func (s *matchService) AddPlayerToMatchSquad(ctx context.Context, matchID uuid.U
 		return nil, err
 	}
 
+	if squadPlayer.InPlaying11 {
+		squadPlayer.FitnessWarning = s.checkFitness(ctx, match, squadPlayer.PlayerID)
+	}
+
+	s.publishSquad(ctx, matchID, domain.SquadPlayerAdded, squadPlayer.TeamID, squadPlayer.PlayerID, squadPlayer)
+
 	return squadPlayer, nil
 }
 
@@ -513,12 +545,23 @@ func (s *matchService) RemovePlayerFromMatchSquad(ctx context.Context, matchID,
 		return err
 	}
 
+	player, err := s.repo.GetPlayerByID(ctx, playerID)
+	if err != nil {
+		return fmt.Errorf("player not found")
+	}
+
 	// Check authorization
-	if match.CreatedBy != userID {
-		return fmt.Errorf("not authorized to manage squad")
+	if err := s.canPickSquad(ctx, match, player.TeamID, userID); err != nil {
+		return err
+	}
+
+	if err := s.repo.RemovePlayerFromSquad(ctx, matchID, playerID); err != nil {
+		return err
 	}
 
-	return s.repo.RemovePlayerFromSquad(ctx, matchID, playerID)
+	s.publishSquad(ctx, matchID, domain.SquadPlayerRemoved, player.TeamID, playerID, nil)
+
+	return nil
 }
 
 func (s *matchService) GetMatchSquad(ctx context.Context, matchID uuid.UUID) ([]domain.MatchSquad, error) {
@@ -532,9 +575,22 @@ func (s *matchService) UpdateSquadPlayer(ctx context.Context, matchID uuid.UUID,
 		return nil, err
 	}
 
+	// Validate team is in this match
+	if req.TeamID != match.TeamAID && req.TeamID != match.TeamBID {
+		return nil, fmt.Errorf("team is not part of this match")
+	}
+
 	// Check authorization
-	if match.CreatedBy != userID {
-		return nil, fmt.Errorf("not authorized to manage squad")
+	if err := s.canPickSquad(ctx, match, req.TeamID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPlayerTeam(ctx, req.PlayerID, req.TeamID, "player"); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSquadLimits(ctx, match, req.TeamID, req.PlayerID, req.InPlaying11); err != nil {
+		return nil, err
 	}
 
 	squadPlayer := &domain.MatchSquad{
@@ -552,5 +608,28 @@ func (s *matchService) UpdateSquadPlayer(ctx context.Context, matchID uuid.UUID,
 		return nil, err
 	}
 
+	if squadPlayer.InPlaying11 {
+		squadPlayer.FitnessWarning = s.checkFitness(ctx, match, squadPlayer.PlayerID)
+	}
+
+	s.publishSquad(ctx, matchID, domain.SquadPlayerUpdated, squadPlayer.TeamID, squadPlayer.PlayerID, squadPlayer)
+
 	return squadPlayer, nil
 }
+
+// checkFitness asks the fitness check about a player named in the playing 11.
+// Selection is the team's call, so a concern is only a warning and a failed
+// check is logged rather than blocking the squad change.
+func (s *matchService) checkFitness(ctx context.Context, match *domain.Match, playerID uuid.UUID) string {
+	if s.fitnessCheck == nil {
+		return ""
+	}
+
+	warning, err := s.fitnessCheck(ctx, playerID, match.MatchDate)
+	if err != nil {
+		log.Printf("fitness check for player %s in match %s failed: %v", playerID, match.ID, err)
+		return ""
+	}
+
+	return warning
+}
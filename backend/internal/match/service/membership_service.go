@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+)
+
+// Membership operations
+
+func (s *matchService) InvitePlayer(ctx context.Context, teamID uuid.UUID, req domain.InvitePlayerRequest, userID uuid.UUID) (*domain.MembershipRequest, error) {
+	if err := validatePlayerDetails(req.JerseyNumber, req.Role); err != nil {
+		return nil, err
+	}
+	if req.UserID == uuid.Nil {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	if _, err := s.managedTeam(ctx, teamID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNotMember(ctx, teamID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	invitation := &domain.MembershipRequest{
+		ID:           uuid.New(),
+		TeamID:       teamID,
+		UserID:       req.UserID,
+		Kind:         domain.MembershipInvitation,
+		Status:       domain.MembershipPending,
+		RequestedBy:  userID,
+		JerseyNumber: req.JerseyNumber,
+		Role:         req.Role,
+		Batting:      req.Batting,
+		Bowling:      req.Bowling,
+		Message:      req.Message,
+	}
+
+	if err := s.repo.CreateMembershipRequest(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetMembershipRequest(ctx, invitation.ID)
+}
+
+func (s *matchService) RequestToJoinTeam(ctx context.Context, teamID uuid.UUID, req domain.JoinTeamRequest, userID uuid.UUID) (*domain.MembershipRequest, error) {
+	if err := validatePlayerDetails(req.JerseyNumber, req.Role); err != nil {
+		return nil, err
+	}
+
+	team, err := s.repo.GetTeamByID(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("team not found")
+	}
+	if !team.IsActive {
+		return nil, fmt.Errorf("team is not active")
+	}
+
+	if err := s.checkNotMember(ctx, teamID, userID); err != nil {
+		return nil, err
+	}
+
+	joinRequest := &domain.MembershipRequest{
+		ID:           uuid.New(),
+		TeamID:       teamID,
+		UserID:       userID,
+		Kind:         domain.MembershipJoinRequest,
+		Status:       domain.MembershipPending,
+		RequestedBy:  userID,
+		JerseyNumber: req.JerseyNumber,
+		Role:         req.Role,
+		Batting:      req.Batting,
+		Bowling:      req.Bowling,
+		Message:      req.Message,
+	}
+
+	if err := s.repo.CreateMembershipRequest(ctx, joinRequest); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetMembershipRequest(ctx, joinRequest.ID)
+}
+
+// ListTeamMembershipRequests lists a team's pending invitations or join requests
+func (s *matchService) ListTeamMembershipRequests(ctx context.Context, teamID uuid.UUID, kind string, userID uuid.UUID) (*domain.MembershipListResponse, error) {
+	if _, err := s.managedTeam(ctx, teamID, userID); err != nil {
+		return nil, err
+	}
+
+	status := domain.MembershipPending
+	return s.listMembershipRequests(ctx, domain.MembershipFilters{TeamID: &teamID, Kind: &kind, Status: &status})
+}
+
+// ListUserMembershipRequests lists the pending invitations or join requests of a user
+func (s *matchService) ListUserMembershipRequests(ctx context.Context, kind string, userID uuid.UUID) (*domain.MembershipListResponse, error) {
+	status := domain.MembershipPending
+	return s.listMembershipRequests(ctx, domain.MembershipFilters{UserID: &userID, Kind: &kind, Status: &status})
+}
+
+func (s *matchService) RespondToInvitation(ctx context.Context, requestID uuid.UUID, accept bool, userID uuid.UUID) (*domain.MembershipRequest, error) {
+	invitation, err := s.pendingMembershipRequest(ctx, requestID, domain.MembershipInvitation)
+	if err != nil {
+		return nil, err
+	}
+
+	if invitation.UserID != userID {
+		return nil, fmt.Errorf("not authorized to respond to this invitation")
+	}
+
+	return s.closeMembershipRequest(ctx, invitation, accept, userID)
+}
+
+func (s *matchService) ReviewJoinRequest(ctx context.Context, requestID uuid.UUID, approve bool, userID uuid.UUID) (*domain.MembershipRequest, error) {
+	joinRequest, err := s.pendingMembershipRequest(ctx, requestID, domain.MembershipJoinRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.managedTeam(ctx, joinRequest.TeamID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.closeMembershipRequest(ctx, joinRequest, approve, userID)
+}
+
+// CancelMembershipRequest withdraws a pending request. Invitations are
+// withdrawn by the team, join requests by the user who made them.
+func (s *matchService) CancelMembershipRequest(ctx context.Context, requestID uuid.UUID, kind string, userID uuid.UUID) error {
+	req, err := s.pendingMembershipRequest(ctx, requestID, kind)
+	if err != nil {
+		return err
+	}
+
+	if kind == domain.MembershipInvitation {
+		if _, err := s.managedTeam(ctx, req.TeamID, userID); err != nil {
+			return err
+		}
+	} else if req.UserID != userID {
+		return fmt.Errorf("not authorized to cancel this join request")
+	}
+
+	return s.repo.CloseMembershipRequest(ctx, requestID, domain.MembershipCancelled, userID)
+}
+
+func (s *matchService) listMembershipRequests(ctx context.Context, filters domain.MembershipFilters) (*domain.MembershipListResponse, error) {
+	requests, err := s.repo.ListMembershipRequests(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.MembershipListResponse{
+		Requests: requests,
+		Total:    len(requests),
+	}, nil
+}
+
+// closeMembershipRequest accepts a request, adding the player, or declines it
+func (s *matchService) closeMembershipRequest(ctx context.Context, req *domain.MembershipRequest, accept bool, userID uuid.UUID) (*domain.MembershipRequest, error) {
+	if accept {
+		if _, err := s.repo.AcceptMembershipRequest(ctx, req, userID); err != nil {
+			return nil, err
+		}
+	} else if err := s.repo.CloseMembershipRequest(ctx, req.ID, domain.MembershipDeclined, userID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetMembershipRequest(ctx, req.ID)
+}
+
+// pendingMembershipRequest loads a pending request of the given kind
+func (s *matchService) pendingMembershipRequest(ctx context.Context, requestID uuid.UUID, kind string) (*domain.MembershipRequest, error) {
+	req, err := s.repo.GetMembershipRequest(ctx, requestID)
+	if err != nil || req.Kind != kind {
+		if kind == domain.MembershipInvitation {
+			return nil, fmt.Errorf("invitation not found")
+		}
+		return nil, fmt.Errorf("join request not found")
+	}
+
+	if req.Status != domain.MembershipPending {
+		return nil, fmt.Errorf("request has already been %s", req.Status)
+	}
+
+	return req, nil
+}
+
+// managedTeam checks that userID can manage the team's players
+func (s *matchService) managedTeam(ctx context.Context, teamID, userID uuid.UUID) (string, error) {
+	return s.requireTeamRole(ctx, teamID, userID, domain.TeamSelectors, "manage players")
+}
+
+func (s *matchService) checkNotMember(ctx context.Context, teamID, userID uuid.UUID) error {
+	member, err := s.repo.IsActiveTeamMember(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if member {
+		return fmt.Errorf("user is already a player in this team")
+	}
+	return nil
+}
+
+func validatePlayerDetails(jerseyNumber int, role string) error {
+	if jerseyNumber < 1 || jerseyNumber > 99 {
+		return fmt.Errorf("jersey number must be between 1 and 99")
+	}
+
+	validRoles := map[string]bool{
+		"batsman": true, "bowler": true, "all-rounder": true, "wicket-keeper": true,
+	}
+	if !validRoles[role] {
+		return fmt.Errorf("invalid player role")
+	}
+
+	return nil
+}
@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+)
+
+var validResultTypes = map[string]bool{
+	domain.ResultNormal: true, domain.ResultTie: true, domain.ResultDraw: true,
+	domain.ResultNoResult: true, domain.ResultAbandoned: true,
+}
+
+// Result operations
+
+// completedResult works out the result stored when a match is completed. The
+// result is computed from the recorded deliveries; a result given by the
+// caller must agree with it unless an override reason is supplied. Matches
+// scored elsewhere, with no deliveries recorded, keep the caller's result.
+func (s *matchService) completedResult(ctx context.Context, match *domain.Match, req domain.UpdateMatchStatusRequest) (map[string]interface{}, error) {
+	if err := validateResultRequest(match, req); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.repo.ListDeliveries(ctx, match.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		if req.RevisedOvers != nil {
+			return nil, fmt.Errorf("revised overs need the chase to be scored ball by ball")
+		}
+		return manualResult(req), nil
+	}
+
+	innings, err := s.repo.ListInnings(ctx, match.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	computed := computeResult(match, summarizeInnings(match, innings, deliveries), req.RevisedOvers)
+	manual := req.WinnerTeamID != nil || req.WinMargin != nil || req.ResultType != nil
+	override := req.OverrideReason != nil && strings.TrimSpace(*req.OverrideReason) != ""
+
+	if computed == nil {
+		// The chase was not finished, so only a washout fits the scores
+		if req.ResultType != nil && (*req.ResultType == domain.ResultNoResult || *req.ResultType == domain.ResultAbandoned) && req.WinnerTeamID == nil {
+			return manualResult(req), nil
+		}
+		if !override {
+			return nil, fmt.Errorf("the recorded scores do not decide the match; finish scoring, set result_type to no-result or abandoned, or give an override_reason")
+		}
+		return overrideResult(req), nil
+	}
+
+	if manual && contradictsResult(req, computed) {
+		if !override {
+			return nil, fmt.Errorf("result contradicts the recorded scores (%s); give an override_reason to record it anyway", s.describeResult(ctx, computed))
+		}
+		return overrideResult(req), nil
+	}
+
+	return resultFields(computed), nil
+}
+
+func validateResultRequest(match *domain.Match, req domain.UpdateMatchStatusRequest) error {
+	if req.ResultType != nil && !validResultTypes[*req.ResultType] {
+		return fmt.Errorf("invalid result type: %s", *req.ResultType)
+	}
+	if req.WinnerTeamID != nil && *req.WinnerTeamID != match.TeamAID && *req.WinnerTeamID != match.TeamBID {
+		return fmt.Errorf("winner must be one of the teams in this match")
+	}
+	if req.RevisedOvers != nil {
+		if !isLimitedOvers(match) {
+			return fmt.Errorf("revised overs only apply to limited-overs matches")
+		}
+		if *req.RevisedOvers < 1 || (match.TotalOvers > 0 && *req.RevisedOvers >= match.TotalOvers) {
+			return fmt.Errorf("revised overs must be between 1 and %d", match.TotalOvers-1)
+		}
+	}
+	return nil
+}
+
+// computeResult derives the result from innings totals, or returns nil when
+// the scores do not decide a limited-overs match
+func computeResult(match *domain.Match, scores []domain.InningsScore, revisedOvers *int) *domain.MatchResult {
+	if isLimitedOvers(match) {
+		return limitedOversResult(match, scores, revisedOvers)
+	}
+	return multiDayResult(scores)
+}
+
+func limitedOversResult(match *domain.Match, scores []domain.InningsScore, revisedOvers *int) *domain.MatchResult {
+	var regular, superOvers []domain.InningsScore
+	for _, score := range scores {
+		if score.IsSuperOver {
+			superOvers = append(superOvers, score)
+		} else {
+			regular = append(regular, score)
+		}
+	}
+	if len(regular) < 2 {
+		return nil
+	}
+	first, chase := regular[0], regular[1]
+
+	// The chase's target and overs, revised if an interruption shortened it,
+	// unless the overs are revised as the match is completed
+	target := first.Runs + 1
+	if chase.Target != nil {
+		target = *chase.Target
+	}
+	chaseOvers := chase.MaxOvers
+	revised := chase.RevisedOvers
+	if revised == nil {
+		revised = first.RevisedOvers
+	}
+	if revisedOvers != nil && first.MaxOvers != nil {
+		target = revisedTarget(first.Runs, *first.MaxOvers, *revisedOvers)
+		chaseOvers = revisedOvers
+		revised = revisedOvers
+	}
+
+	result := chaseResult(first, chase, target, chaseOvers)
+	if result == nil {
+		return nil
+	}
+	result.RevisedOvers = revised
+	if result.ResultType != domain.ResultTie || len(superOvers) == 0 {
+		return result
+	}
+
+	// Super overs are played in pairs until one is not tied
+	for i := 0; i < len(superOvers); i += 2 {
+		if i+1 >= len(superOvers) {
+			return nil
+		}
+		decider := chaseResult(superOvers[i], superOvers[i+1], superOvers[i].Runs+1, superOvers[i+1].MaxOvers)
+		if decider == nil {
+			return nil
+		}
+		if decider.ResultType == domain.ResultTie {
+			continue
+		}
+		result.ResultType = domain.ResultNormal
+		result.WinnerTeamID = decider.WinnerTeamID
+		result.WinMargin = "super over"
+		result.MarginRuns, result.MarginWickets, result.BallsRemaining = nil, nil, nil
+		return result
+	}
+
+	return result
+}
+
+// revisedTarget scales a first innings total to a chase shortened to
+// chaseOvers. Without DLS the chase keeps the first innings run rate.
+func revisedTarget(firstRuns, firstOvers, chaseOvers int) int {
+	return firstRuns*chaseOvers/firstOvers + 1
+}
+
+// chaseResult decides a contest between a first innings and a chase of
+// target within maxOvers, or returns nil while the chase is still on
+func chaseResult(first, chase domain.InningsScore, target int, maxOvers *int) *domain.MatchResult {
+	result := &domain.MatchResult{
+		ResultType: domain.ResultNormal,
+		Target:     &target,
+	}
+
+	chaseBalls := 0
+	if maxOvers != nil {
+		chaseBalls = *maxOvers * 6
+	}
+
+	if chase.Runs >= target {
+		winner := chase.BattingTeamID
+		wickets := chase.MaxWickets - chase.Wickets
+		result.WinnerTeamID = &winner
+		result.MarginWickets = &wickets
+		result.WinMargin = plural(wickets, "wicket")
+		if chaseBalls > 0 {
+			remaining := chaseBalls - chase.LegalBalls
+			if remaining < 0 {
+				remaining = 0
+			}
+			result.BallsRemaining = &remaining
+		}
+		return result
+	}
+
+	chaseOver := chase.IsComplete || (chaseBalls > 0 && chase.LegalBalls >= chaseBalls)
+	if !chaseOver {
+		return nil
+	}
+
+	if chase.Runs == target-1 {
+		result.ResultType = domain.ResultTie
+		return result
+	}
+
+	winner := first.BattingTeamID
+	runs := target - 1 - chase.Runs
+	result.WinnerTeamID = &winner
+	result.MarginRuns = &runs
+	result.WinMargin = plural(runs, "run")
+	return result
+}
+
+// multiDayResult decides a Test from up to four innings. An innings is
+// finished when the side is all out or declares; anything the scores leave
+// open is a draw.
+func multiDayResult(scores []domain.InningsScore) *domain.MatchResult {
+	draw := &domain.MatchResult{ResultType: domain.ResultDraw}
+	if len(scores) < 2 {
+		return draw
+	}
+
+	totals := make(map[uuid.UUID]int)
+	innings := make(map[uuid.UUID]int)
+	for _, score := range scores {
+		totals[score.BattingTeamID] += score.Runs
+		innings[score.BattingTeamID]++
+	}
+
+	// A side that batted twice and is still behind loses by an innings
+	if len(scores) == 3 && scores[2].IsComplete {
+		twice := scores[2].BattingTeamID
+		once := scores[2].BowlingTeamID
+		if innings[twice] == 2 && totals[twice] < totals[once] {
+			runs := totals[once] - totals[twice]
+			return &domain.MatchResult{
+				ResultType:   domain.ResultNormal,
+				WinnerTeamID: &once,
+				MarginRuns:   &runs,
+				WinMargin:    "an innings and " + plural(runs, "run"),
+			}
+		}
+	}
+
+	if len(scores) < 4 {
+		return draw
+	}
+
+	last := scores[3]
+	chasing, defending := last.BattingTeamID, last.BowlingTeamID
+	target := totals[defending] - (totals[chasing] - last.Runs) + 1
+
+	if totals[chasing] > totals[defending] {
+		wickets := last.MaxWickets - last.Wickets
+		return &domain.MatchResult{
+			ResultType:    domain.ResultNormal,
+			WinnerTeamID:  &chasing,
+			MarginWickets: &wickets,
+			WinMargin:     plural(wickets, "wicket"),
+			Target:        &target,
+		}
+	}
+
+	if !last.IsComplete {
+		return draw
+	}
+
+	if totals[chasing] == totals[defending] {
+		return &domain.MatchResult{ResultType: domain.ResultTie, Target: &target}
+	}
+
+	runs := totals[defending] - totals[chasing]
+	return &domain.MatchResult{
+		ResultType:   domain.ResultNormal,
+		WinnerTeamID: &defending,
+		MarginRuns:   &runs,
+		WinMargin:    plural(runs, "run"),
+		Target:       &target,
+	}
+}
+
+// contradictsResult reports whether any result detail given by the caller
+// disagrees with the computed result
+func contradictsResult(req domain.UpdateMatchStatusRequest, computed *domain.MatchResult) bool {
+	if req.ResultType != nil && *req.ResultType != computed.ResultType {
+		return true
+	}
+	if req.WinnerTeamID != nil && (computed.WinnerTeamID == nil || *req.WinnerTeamID != *computed.WinnerTeamID) {
+		return true
+	}
+	if req.WinMargin != nil && !strings.EqualFold(strings.TrimSpace(*req.WinMargin), computed.WinMargin) {
+		return true
+	}
+	return false
+}
+
+// describeResult renders a computed result for error messages
+func (s *matchService) describeResult(ctx context.Context, result *domain.MatchResult) string {
+	switch {
+	case result.WinnerTeamID != nil:
+		winner := "team " + result.WinnerTeamID.String()
+		if team, err := s.repo.GetTeamByID(ctx, *result.WinnerTeamID); err == nil {
+			winner = team.Name
+		}
+		return fmt.Sprintf("%s won by %s", winner, result.WinMargin)
+	case result.ResultType == domain.ResultTie:
+		return "match tied"
+	default:
+		return "match drawn"
+	}
+}
+
+// resultFields converts a computed result into the stored result fields
+func resultFields(result *domain.MatchResult) map[string]interface{} {
+	fields := map[string]interface{}{"result_type": result.ResultType}
+	if result.WinnerTeamID != nil {
+		fields["winner_team_id"] = result.WinnerTeamID.String()
+		fields["win_margin"] = result.WinMargin
+	}
+	if result.MarginRuns != nil {
+		fields["margin_runs"] = *result.MarginRuns
+	}
+	if result.MarginWickets != nil {
+		fields["margin_wickets"] = *result.MarginWickets
+	}
+	if result.BallsRemaining != nil {
+		fields["balls_remaining"] = *result.BallsRemaining
+	}
+	if result.Target != nil {
+		fields["target"] = *result.Target
+	}
+	if result.RevisedOvers != nil {
+		fields["revised_overs"] = *result.RevisedOvers
+	}
+	return fields
+}
+
+// manualResult converts the result given by the caller into stored result fields
+func manualResult(req domain.UpdateMatchStatusRequest) map[string]interface{} {
+	fields := make(map[string]interface{})
+	if req.WinnerTeamID != nil {
+		fields["winner_team_id"] = req.WinnerTeamID.String()
+	}
+	if req.WinMargin != nil {
+		fields["win_margin"] = *req.WinMargin
+	}
+	if req.ResultType != nil {
+		fields["result_type"] = *req.ResultType
+	}
+	return fields
+}
+
+// overrideResult records the caller's result along with why it was overridden
+func overrideResult(req domain.UpdateMatchStatusRequest) map[string]interface{} {
+	fields := manualResult(req)
+	fields["override_reason"] = strings.TrimSpace(*req.OverrideReason)
+	if req.RevisedOvers != nil {
+		fields["revised_overs"] = *req.RevisedOvers
+	}
+	return fields
+}
+
+// plural renders a count with its unit ("1 wicket", "32 runs")
+func plural(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+)
+
+// freeHitWicketTypes are the only ways a batter can be out off a free hit:
+// those allowed off a no-ball, and retiring or being timed out, which do not
+// depend on the delivery at all
+var freeHitWicketTypes = map[string]bool{
+	"run_out": true, "obstructing": true, "hit_twice": true,
+	"retired_hurt": true, "retired_out": true, "timed_out": true,
+}
+
+// Rules operations
+
+func (s *matchService) GetMatchRules(ctx context.Context, matchID uuid.UUID) (*domain.MatchRules, error) {
+	match, err := s.repo.GetMatchByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("match not found")
+	}
+
+	return s.matchRules(ctx, match)
+}
+
+// matchRules resolves the rules profile of a match: its format's defaults,
+// overridden by the rules of the tournament it belongs to, if any
+func (s *matchService) matchRules(ctx context.Context, match *domain.Match) (*domain.MatchRules, error) {
+	rules, ok := domain.FormatRules(match.MatchFormat)
+	if !ok {
+		return nil, fmt.Errorf("no rules for match format %s", match.MatchFormat)
+	}
+
+	overrides, err := s.repo.GetTournamentRules(ctx, match.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules = rules.WithOverrides(overrides)
+	return &rules, nil
+}
+
+// checkOvers validates the overs set for a match against its rules. Limited
+// overs innings can be shortened but not lengthened; for multi-day matches
+// the overs are the day's quota.
+func checkOvers(rules *domain.MatchRules, overs int) error {
+	if overs < 1 {
+		return fmt.Errorf("total overs must be at least 1")
+	}
+	if rules.OversPerInnings > 0 && overs > rules.OversPerInnings {
+		return fmt.Errorf("a %s match has at most %d overs per innings", rules.Format, rules.OversPerInnings)
+	}
+	return nil
+}
+
+// checkSquadLimits verifies that naming a player keeps the team's squad and
+// playing XI within the sizes the rules allow
+func (s *matchService) checkSquadLimits(ctx context.Context, match *domain.Match, teamID, playerID uuid.UUID, inPlaying11 bool) error {
+	rules, err := s.matchRules(ctx, match)
+	if err != nil {
+		return err
+	}
+
+	squad, err := s.repo.GetTeamSquad(ctx, match.ID, teamID)
+	if err != nil {
+		return err
+	}
+
+	named, playing := 0, 0
+	for _, p := range squad {
+		if p.PlayerID == playerID {
+			continue
+		}
+		named++
+		if p.InPlaying11 {
+			playing++
+		}
+	}
+
+	if named >= rules.SquadSize {
+		return fmt.Errorf("squad is full: at most %d players can be named", rules.SquadSize)
+	}
+	if inPlaying11 && playing >= rules.PlayersPerSide {
+		return fmt.Errorf("playing %d is full", rules.PlayersPerSide)
+	}
+	return nil
+}
+
+// checkBowlerQuota rejects a delivery that would start an over beyond the
+// bowler's quota for the innings
+func checkBowlerQuota(rules *domain.MatchRules, innings domain.Innings, inningsDeliveries []domain.Delivery, bowlerID uuid.UUID, overNumber int) error {
+	overs := innings.OversLimit()
+	if innings.IsSuperOver || overs == nil {
+		return nil
+	}
+
+	quota := rules.BowlerQuota(*overs)
+	if quota == 0 {
+		return nil
+	}
+
+	bowled := make(map[int]bool)
+	for _, d := range inningsDeliveries {
+		if d.BowlerID == bowlerID {
+			bowled[d.OverNumber] = true
+		}
+	}
+	if !bowled[overNumber] && len(bowled) >= quota {
+		return fmt.Errorf("bowler has already bowled their quota of %s", plural(quota, "over"))
+	}
+	return nil
+}
+
+// isFreeHit reports whether the next delivery of an innings is a free hit:
+// it follows a no-ball, or a wide or no-ball bowled on a free hit
+func isFreeHit(rules *domain.MatchRules, inningsDeliveries []domain.Delivery) bool {
+	if !rules.FreeHit {
+		return false
+	}
+
+	freeHit := false
+	for _, d := range inningsDeliveries {
+		switch {
+		case d.ExtraType != nil && *d.ExtraType == "no_ball":
+			freeHit = true
+		case freeHit && d.ExtraType != nil && *d.ExtraType == "wide":
+			// The free hit carries over to the next delivery
+		default:
+			freeHit = false
+		}
+	}
+	return freeHit
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+)
+
+// bowlerWickets are the dismissals credited to the bowler
+var bowlerWickets = map[string]bool{
+	"bowled": true, "caught": true, "lbw": true, "stumped": true, "hit_wicket": true,
+}
+
+// Scorecard operations
+
+func (s *matchService) GetScorecard(ctx context.Context, matchID uuid.UUID) (*domain.Scorecard, error) {
+	match, err := s.repo.GetMatchByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	innings, deliveries, err := s.loadInnings(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	squad, err := s.repo.GetMatchSquad(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := s.repo.GetMatchPlayerNames(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	playerName := func(id uuid.UUID) string {
+		if name, ok := names[id]; ok {
+			return name
+		}
+		return "Unknown player"
+	}
+
+	teamNames := map[uuid.UUID]string{}
+	for _, teamID := range []uuid.UUID{match.TeamAID, match.TeamBID} {
+		if teamID == uuid.Nil {
+			continue
+		}
+		team, err := s.repo.GetTeamByID(ctx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		teamNames[teamID] = team.Name
+	}
+	teamName := func(id uuid.UUID) string {
+		if name, ok := teamNames[id]; ok {
+			return name
+		}
+		return "TBD"
+	}
+
+	card := &domain.Scorecard{
+		Match:   match,
+		TeamA:   teamName(match.TeamAID),
+		TeamB:   teamName(match.TeamBID),
+		Innings: []domain.InningsCard{},
+	}
+
+	for _, score := range summarizeInnings(match, innings, deliveries) {
+		innings := buildInningsCard(score, filterInnings(deliveries, score.Innings), squad, playerName)
+		innings.BattingTeam = teamName(score.BattingTeamID)
+		innings.BowlingTeam = teamName(score.BowlingTeamID)
+		card.Innings = append(card.Innings, innings)
+	}
+
+	card.Summary = summarizeResult(match, buildLiveScore(match, innings, deliveries), teamName)
+
+	return card, nil
+}
+
+// buildInningsCard derives the batting and bowling cards of one innings from its deliveries
+func buildInningsCard(score domain.InningsScore, deliveries []domain.Delivery, squad []domain.MatchSquad, playerName func(uuid.UUID) string) domain.InningsCard {
+	card := domain.InningsCard{
+		InningsScore:  score,
+		Batting:       []domain.BattingEntry{},
+		DidNotBat:     []domain.ScorecardPlayer{},
+		FallOfWickets: []domain.FallOfWicket{},
+		Bowling:       []domain.BowlingEntry{},
+	}
+
+	batting := make(map[uuid.UUID]int)
+	batter := func(id uuid.UUID) *domain.BattingEntry {
+		pos, ok := batting[id]
+		if !ok {
+			card.Batting = append(card.Batting, domain.BattingEntry{
+				PlayerID:  id,
+				Name:      playerName(id),
+				Dismissal: "not out",
+			})
+			pos = len(card.Batting) - 1
+			batting[id] = pos
+		}
+		return &card.Batting[pos]
+	}
+
+	bowling := make(map[uuid.UUID]int)
+	bowler := func(id uuid.UUID) *domain.BowlingEntry {
+		pos, ok := bowling[id]
+		if !ok {
+			card.Bowling = append(card.Bowling, domain.BowlingEntry{
+				PlayerID: id,
+				Name:     playerName(id),
+			})
+			pos = len(card.Bowling) - 1
+			bowling[id] = pos
+		}
+		return &card.Bowling[pos]
+	}
+
+	// Runs and legal balls per over, to find maidens
+	type overKey struct {
+		bowlerID uuid.UUID
+		over     int
+	}
+	overRuns := make(map[overKey]int)
+	overBalls := make(map[overKey]int)
+
+	runs, wickets, legalBalls := 0, 0, 0
+	for i := range deliveries {
+		d := &deliveries[i]
+		striker := batter(d.StrikerID)
+		batter(d.NonStrikerID)
+		b := bowler(d.BowlerID)
+
+		runs += d.RunsOffBat + d.Extras
+
+		// Batting
+		striker.Runs += d.RunsOffBat
+		if d.ExtraType == nil || *d.ExtraType != "wide" {
+			striker.Balls++
+		}
+		switch d.RunsOffBat {
+		case 4:
+			striker.Fours++
+		case 6:
+			striker.Sixes++
+		}
+
+		// Bowling: byes, leg byes and penalties are not charged to the bowler
+		conceded := d.RunsOffBat
+		if d.ExtraType != nil {
+			switch *d.ExtraType {
+			case "wide":
+				conceded += d.Extras
+				b.Wides += d.Extras
+			case "no_ball":
+				conceded += d.Extras
+				b.NoBalls += d.Extras
+			}
+		}
+		b.Runs += conceded
+		key := overKey{bowlerID: d.BowlerID, over: d.OverNumber}
+		overRuns[key] += conceded
+		if isLegalDelivery(d) {
+			b.LegalBalls++
+			overBalls[key]++
+			legalBalls++
+		}
+
+		// Wicket
+		if !d.IsWicket {
+			continue
+		}
+		dismissedID := d.StrikerID
+		if d.DismissedPlayerID != nil {
+			dismissedID = *d.DismissedPlayerID
+		}
+		dismissed := batter(dismissedID)
+		dismissed.Dismissal = dismissalText(d, playerName)
+		dismissed.IsOut = countsAsWicket(d)
+
+		if d.WicketType != nil && bowlerWickets[*d.WicketType] {
+			b.Wickets++
+		}
+		if countsAsWicket(d) {
+			wickets++
+			card.FallOfWickets = append(card.FallOfWickets, domain.FallOfWicket{
+				Wicket:   wickets,
+				Runs:     runs,
+				Overs:    formatOvers(legalBalls),
+				PlayerID: dismissedID,
+				Name:     dismissed.Name,
+			})
+		}
+	}
+
+	for i := range card.Batting {
+		entry := &card.Batting[i]
+		if entry.Balls > 0 {
+			entry.StrikeRate = float64(entry.Runs) * 100 / float64(entry.Balls)
+		}
+	}
+
+	for key, balls := range overBalls {
+		if balls == 6 && overRuns[key] == 0 {
+			bowler(key.bowlerID).Maidens++
+		}
+	}
+	for i := range card.Bowling {
+		entry := &card.Bowling[i]
+		entry.Overs = formatOvers(entry.LegalBalls)
+		if entry.LegalBalls > 0 {
+			entry.Economy = float64(entry.Runs) * 6 / float64(entry.LegalBalls)
+		}
+	}
+
+	// Members of the playing 11 who never reached the crease
+	for _, p := range squad {
+		if score.IsSuperOver || p.TeamID != score.BattingTeamID || !p.InPlaying11 {
+			continue
+		}
+		if _, batted := batting[p.PlayerID]; !batted {
+			card.DidNotBat = append(card.DidNotBat, domain.ScorecardPlayer{
+				PlayerID: p.PlayerID,
+				Name:     playerName(p.PlayerID),
+			})
+		}
+	}
+
+	return card
+}
+
+// dismissalText renders how a batter was out in scorecard notation ("c Smith b Jones")
+func dismissalText(d *domain.Delivery, playerName func(uuid.UUID) string) string {
+	if d.WicketType == nil {
+		return "out"
+	}
+
+	bowler := playerName(d.BowlerID)
+	fielder := ""
+	if d.FielderID != nil {
+		fielder = playerName(*d.FielderID)
+	}
+
+	switch *d.WicketType {
+	case "bowled":
+		return "b " + bowler
+	case "caught":
+		if d.FielderID != nil && *d.FielderID == d.BowlerID {
+			return "c & b " + bowler
+		}
+		if fielder == "" {
+			return "caught b " + bowler
+		}
+		return fmt.Sprintf("c %s b %s", fielder, bowler)
+	case "lbw":
+		return "lbw b " + bowler
+	case "stumped":
+		if fielder == "" {
+			return "stumped b " + bowler
+		}
+		return fmt.Sprintf("st %s b %s", fielder, bowler)
+	case "run_out":
+		if fielder == "" {
+			return "run out"
+		}
+		return fmt.Sprintf("run out (%s)", fielder)
+	case "hit_wicket":
+		return "hit wicket b " + bowler
+	case "retired_hurt":
+		return "retired hurt"
+	case "retired_out":
+		return "retired out"
+	case "timed_out":
+		return "timed out"
+	case "obstructing":
+		return "obstructing the field"
+	case "hit_twice":
+		return "hit the ball twice"
+	}
+
+	return *d.WicketType
+}
+
+// summarizeResult describes the state of a match in one line
+func summarizeResult(match *domain.Match, live *domain.LiveScore, teamName func(uuid.UUID) string) string {
+	switch match.Status {
+	case "cancelled":
+		return "Match cancelled"
+	case "completed":
+		if match.ResultType != nil {
+			switch *match.ResultType {
+			case domain.ResultTie:
+				return "Match tied"
+			case domain.ResultDraw:
+				return "Match drawn"
+			case domain.ResultNoResult:
+				return "No result"
+			case domain.ResultAbandoned:
+				return "Match abandoned"
+			}
+		}
+		if match.WinnerTeamID != nil {
+			if match.WinMargin != nil && *match.WinMargin != "" {
+				return fmt.Sprintf("%s won by %s", teamName(*match.WinnerTeamID), *match.WinMargin)
+			}
+			return fmt.Sprintf("%s won", teamName(*match.WinnerTeamID))
+		}
+		return "Match completed"
+	case "live":
+		if live.Current == nil {
+			if match.TossWonBy != nil && match.TossDecision != nil {
+				return fmt.Sprintf("%s won the toss and chose to %s", teamName(*match.TossWonBy), *match.TossDecision)
+			}
+			return "Match in progress"
+		}
+		if live.RunsRequired != nil {
+			if live.BallsRemaining != nil {
+				return fmt.Sprintf("%s need %d runs from %d balls", teamName(live.Current.BattingTeamID), *live.RunsRequired, *live.BallsRemaining)
+			}
+			return fmt.Sprintf("%s need %d runs", teamName(live.Current.BattingTeamID), *live.RunsRequired)
+		}
+		return fmt.Sprintf("%s %d/%d (%s ov)", teamName(live.Current.BattingTeamID), live.Current.Runs, live.Current.Wickets, live.Current.Overs)
+	}
+
+	return "Match yet to begin"
+}
@@ -0,0 +1,601 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+)
+
+// maxWickets ends an innings scored before innings were recorded (all out)
+const maxWickets = 10
+
+var validExtraTypes = map[string]bool{
+	"wide": true, "no_ball": true, "bye": true, "leg_bye": true, "penalty": true,
+}
+
+var validWicketTypes = map[string]bool{
+	"bowled": true, "caught": true, "lbw": true, "run_out": true, "stumped": true,
+	"hit_wicket": true, "retired_hurt": true, "retired_out": true, "timed_out": true,
+	"obstructing": true, "hit_twice": true,
+}
+
+// Scoring operations
+
+func (s *matchService) RecordDelivery(ctx context.Context, matchID uuid.UUID, req domain.RecordDeliveryRequest, userID uuid.UUID) (*domain.Delivery, error) {
+	// Get match
+	match, err := s.repo.GetMatchByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization
+	if match.CreatedBy != userID {
+		return nil, fmt.Errorf("not authorized to score this match")
+	}
+
+	if match.Status != "live" {
+		return nil, fmt.Errorf("deliveries can only be recorded for live matches")
+	}
+
+	rules, err := s.matchRules(ctx, match)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateDeliveryRequest(req, rules); err != nil {
+		return nil, err
+	}
+
+	// Resolve teams
+	if req.BattingTeamID != match.TeamAID && req.BattingTeamID != match.TeamBID {
+		return nil, fmt.Errorf("batting team is not part of this match")
+	}
+	bowlingTeamID := match.TeamAID
+	if req.BattingTeamID == match.TeamAID {
+		bowlingTeamID = match.TeamBID
+	}
+
+	// Validate players belong to the right sides
+	if err := s.checkPlayerTeam(ctx, req.StrikerID, req.BattingTeamID, "striker"); err != nil {
+		return nil, err
+	}
+	if err := s.checkPlayerTeam(ctx, req.NonStrikerID, req.BattingTeamID, "non-striker"); err != nil {
+		return nil, err
+	}
+	if err := s.checkPlayerTeam(ctx, req.BowlerID, bowlingTeamID, "bowler"); err != nil {
+		return nil, err
+	}
+	if req.FielderID != nil {
+		if err := s.checkPlayerTeam(ctx, *req.FielderID, bowlingTeamID, "fielder"); err != nil {
+			return nil, err
+		}
+	}
+
+	// Load what has been scored so far
+	deliveries, err := s.repo.ListDeliveries(ctx, matchID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	innings, err := s.repo.ListInnings(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Innings < len(innings) {
+		return nil, fmt.Errorf("innings %d has already finished", req.Innings)
+	}
+	if req.Innings > len(innings)+1 {
+		return nil, fmt.Errorf("innings %d has not started yet", len(innings)+1)
+	}
+
+	// The first two innings start with their first delivery, later ones
+	// (follow-on, super overs) must be started explicitly
+	if req.Innings == len(innings)+1 {
+		if req.Innings > 2 {
+			return nil, fmt.Errorf("innings %d must be started before deliveries are recorded", req.Innings)
+		}
+		next, err := s.nextInnings(match, rules, innings, summarizeInnings(match, innings, deliveries), domain.StartInningsRequest{BattingTeamID: req.BattingTeamID}, userID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.repo.CreateInnings(ctx, next); err != nil {
+			return nil, err
+		}
+		innings = append(innings, *next)
+		s.publish(ctx, matchID, domain.EventInnings, next)
+	}
+
+	if innings[req.Innings-1].BattingTeamID != req.BattingTeamID {
+		return nil, fmt.Errorf("batting team does not match innings %d", req.Innings)
+	}
+
+	scores := summarizeInnings(match, innings, deliveries)
+	for _, score := range scores {
+		if score.Innings == req.Innings && score.IsComplete {
+			return nil, fmt.Errorf("innings %d is complete", req.Innings)
+		}
+	}
+
+	inningsDeliveries := filterInnings(deliveries, req.Innings)
+
+	// Dismissed batters cannot come back to the crease
+	for _, d := range inningsDeliveries {
+		if !d.IsWicket || d.DismissedPlayerID == nil || (d.WicketType != nil && *d.WicketType == "retired_hurt") {
+			continue
+		}
+		if *d.DismissedPlayerID == req.StrikerID || *d.DismissedPlayerID == req.NonStrikerID {
+			return nil, fmt.Errorf("batter has already been dismissed in this innings")
+		}
+	}
+
+	// Work out where in the innings this ball falls
+	legalBalls := 0
+	var lastLegal *domain.Delivery
+	for i := range inningsDeliveries {
+		if isLegalDelivery(&inningsDeliveries[i]) {
+			legalBalls++
+			lastLegal = &inningsDeliveries[i]
+		}
+	}
+	overNumber := legalBalls / 6
+	ballNumber := legalBalls%6 + 1
+
+	// A bowler cannot bowl consecutive overs
+	if legalBalls > 0 && legalBalls%6 == 0 && lastLegal != nil && lastLegal.BowlerID == req.BowlerID {
+		return nil, fmt.Errorf("bowler cannot bowl consecutive overs")
+	}
+
+	if err := checkBowlerQuota(rules, innings[req.Innings-1], inningsDeliveries, req.BowlerID, overNumber); err != nil {
+		return nil, err
+	}
+
+	if req.IsWicket && isFreeHit(rules, inningsDeliveries) && !freeHitWicketTypes[*req.WicketType] {
+		return nil, fmt.Errorf("batter cannot be out %s off a free hit", *req.WicketType)
+	}
+
+	dismissedPlayerID := req.DismissedPlayerID
+	if req.IsWicket && dismissedPlayerID == nil {
+		dismissedPlayerID = &req.StrikerID
+	}
+
+	delivery := &domain.Delivery{
+		ID:                uuid.New(),
+		MatchID:           matchID,
+		Innings:           req.Innings,
+		Sequence:          len(deliveries) + 1,
+		OverNumber:        overNumber,
+		BallNumber:        ballNumber,
+		BattingTeamID:     req.BattingTeamID,
+		BowlingTeamID:     bowlingTeamID,
+		StrikerID:         req.StrikerID,
+		NonStrikerID:      req.NonStrikerID,
+		BowlerID:          req.BowlerID,
+		RunsOffBat:        req.RunsOffBat,
+		Extras:            req.Extras,
+		ExtraType:         req.ExtraType,
+		IsWicket:          req.IsWicket,
+		WicketType:        req.WicketType,
+		DismissedPlayerID: dismissedPlayerID,
+		FielderID:         req.FielderID,
+		CreatedBy:         userID,
+		CreatedAt:         time.Now(),
+	}
+
+	err = s.repo.CreateDelivery(ctx, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := domain.EventDelivery
+	if delivery.IsWicket {
+		eventType = domain.EventWicket
+	}
+	s.publishDelivery(ctx, match, eventType, delivery)
+
+	return delivery, nil
+}
+
+func (s *matchService) UndoLastDelivery(ctx context.Context, matchID uuid.UUID, userID uuid.UUID) (*domain.Delivery, error) {
+	// Get match
+	match, err := s.repo.GetMatchByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization
+	if match.CreatedBy != userID {
+		return nil, fmt.Errorf("not authorized to score this match")
+	}
+
+	if match.Status != "live" {
+		return nil, fmt.Errorf("deliveries can only be undone for live matches")
+	}
+
+	// An innings started by its first delivery goes with it, so it can be
+	// restarted with the other side batting
+	last, err := s.repo.UndoLastDelivery(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishDelivery(ctx, match, domain.EventDeliveryUndone, last)
+
+	return last, nil
+}
+
+func (s *matchService) ListDeliveries(ctx context.Context, matchID uuid.UUID, innings *int) (*domain.DeliveryListResponse, error) {
+	deliveries, err := s.repo.ListDeliveries(ctx, matchID, innings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.DeliveryListResponse{
+		Deliveries: deliveries,
+		Total:      len(deliveries),
+	}, nil
+}
+
+func (s *matchService) GetLiveScore(ctx context.Context, matchID uuid.UUID) (*domain.LiveScore, error) {
+	match, err := s.repo.GetMatchByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	innings, deliveries, err := s.loadInnings(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildLiveScore(match, innings, deliveries), nil
+}
+
+// loadInnings loads the innings of a match and every delivery bowled in them
+func (s *matchService) loadInnings(ctx context.Context, matchID uuid.UUID) ([]domain.Innings, []domain.Delivery, error) {
+	innings, err := s.repo.ListInnings(ctx, matchID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deliveries, err := s.repo.ListDeliveries(ctx, matchID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return innings, deliveries, nil
+}
+
+// checkPlayerTeam verifies that a player belongs to the given team
+func (s *matchService) checkPlayerTeam(ctx context.Context, playerID, teamID uuid.UUID, label string) error {
+	player, err := s.repo.GetPlayerByID(ctx, playerID)
+	if err != nil {
+		return fmt.Errorf("%s not found", label)
+	}
+	if player.TeamID != teamID {
+		return fmt.Errorf("%s does not belong to the expected team", label)
+	}
+	return nil
+}
+
+func validateDeliveryRequest(req domain.RecordDeliveryRequest, rules *domain.MatchRules) error {
+	if req.Innings < 1 {
+		return fmt.Errorf("innings must be at least 1")
+	}
+	if req.StrikerID == req.NonStrikerID {
+		return fmt.Errorf("striker and non-striker must be different players")
+	}
+	if req.RunsOffBat < 0 || req.Extras < 0 {
+		return fmt.Errorf("runs cannot be negative")
+	}
+	if req.RunsOffBat > 7 {
+		return fmt.Errorf("runs off the bat cannot exceed 7 on a single delivery")
+	}
+
+	// Extras
+	if req.ExtraType == nil {
+		if req.Extras > 0 {
+			return fmt.Errorf("extra type is required when extras are recorded")
+		}
+	} else {
+		if !validExtraTypes[*req.ExtraType] {
+			return fmt.Errorf("invalid extra type: %s", *req.ExtraType)
+		}
+		minExtras := 1
+		switch *req.ExtraType {
+		case "wide":
+			minExtras = rules.WideRuns
+		case "no_ball":
+			minExtras = rules.NoBallRuns
+		}
+		if req.Extras < minExtras {
+			return fmt.Errorf("%s needs at least %s", *req.ExtraType, plural(minExtras, "extra run"))
+		}
+		switch *req.ExtraType {
+		case "wide", "bye", "leg_bye":
+			if req.RunsOffBat > 0 {
+				return fmt.Errorf("runs off the bat cannot be scored from a %s", *req.ExtraType)
+			}
+		}
+	}
+
+	// Wicket
+	if !req.IsWicket {
+		if req.WicketType != nil || req.DismissedPlayerID != nil {
+			return fmt.Errorf("wicket details given but delivery is not marked as a wicket")
+		}
+		return nil
+	}
+	if req.WicketType == nil {
+		return fmt.Errorf("wicket type is required")
+	}
+	if !validWicketTypes[*req.WicketType] {
+		return fmt.Errorf("invalid wicket type: %s", *req.WicketType)
+	}
+	if *req.WicketType == "retired_out" && !rules.RetiredOut {
+		return fmt.Errorf("retiring out is not allowed under %s rules", rules.Format)
+	}
+	if req.DismissedPlayerID != nil && *req.DismissedPlayerID != req.StrikerID && *req.DismissedPlayerID != req.NonStrikerID {
+		return fmt.Errorf("dismissed player must be one of the batters at the crease")
+	}
+	if req.DismissedPlayerID != nil && *req.DismissedPlayerID == req.NonStrikerID {
+		switch *req.WicketType {
+		case "run_out", "obstructing", "retired_hurt", "retired_out", "timed_out":
+		default:
+			return fmt.Errorf("non-striker cannot be dismissed %s", *req.WicketType)
+		}
+	}
+	if req.ExtraType != nil {
+		switch *req.ExtraType {
+		case "wide":
+			switch *req.WicketType {
+			case "stumped", "run_out", "hit_wicket", "obstructing":
+			default:
+				return fmt.Errorf("batter cannot be out %s off a wide", *req.WicketType)
+			}
+		case "no_ball":
+			switch *req.WicketType {
+			case "run_out", "obstructing", "hit_twice":
+			default:
+				return fmt.Errorf("batter cannot be out %s off a no-ball", *req.WicketType)
+			}
+		}
+	}
+	if req.FielderID != nil {
+		switch *req.WicketType {
+		case "caught", "run_out", "stumped":
+		default:
+			return fmt.Errorf("a fielder is only recorded for caught, run out or stumped dismissals")
+		}
+	}
+
+	return nil
+}
+
+// isLimitedOvers reports whether the innings of a match are capped by TotalOvers
+func isLimitedOvers(match *domain.Match) bool {
+	return match.MatchFormat != "Test"
+}
+
+// isLegalDelivery reports whether a delivery counts towards the over
+func isLegalDelivery(d *domain.Delivery) bool {
+	if d.ExtraType == nil {
+		return true
+	}
+	return *d.ExtraType != "wide" && *d.ExtraType != "no_ball"
+}
+
+// countsAsWicket reports whether a dismissal is charged against the batting side
+func countsAsWicket(d *domain.Delivery) bool {
+	return d.IsWicket && (d.WicketType == nil || *d.WicketType != "retired_hurt")
+}
+
+func filterInnings(deliveries []domain.Delivery, innings int) []domain.Delivery {
+	var result []domain.Delivery
+	for _, d := range deliveries {
+		if d.Innings == innings {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+// formatOvers renders a legal ball count as cricket overs notation ("12.3")
+func formatOvers(legalBalls int) string {
+	return fmt.Sprintf("%d.%d", legalBalls/6, legalBalls%6)
+}
+
+// summarizeInnings derives per-innings totals from the innings of a match
+// and its ordered list of deliveries
+func summarizeInnings(match *domain.Match, innings []domain.Innings, deliveries []domain.Delivery) []domain.InningsScore {
+	var scores []domain.InningsScore
+	index := make(map[int]int)
+	limits := make(map[int]domain.Innings)
+
+	for _, in := range innings {
+		scores = append(scores, domain.InningsScore{
+			Innings:       in.Number,
+			BattingTeamID: in.BattingTeamID,
+			BowlingTeamID: in.BowlingTeamID,
+			IsSuperOver:   in.IsSuperOver,
+			FollowOn:      in.FollowOn,
+			Declared:      in.Declared,
+			MaxOvers:      in.OversLimit(),
+			RevisedOvers:  in.RevisedOvers,
+			Target:        in.Target,
+		})
+		index[in.Number] = len(scores) - 1
+		limits[in.Number] = in
+	}
+
+	for i := range deliveries {
+		d := &deliveries[i]
+		pos, ok := index[d.Innings]
+		if !ok {
+			// Scored before innings were recorded
+			scores = append(scores, domain.InningsScore{
+				Innings:       d.Innings,
+				BattingTeamID: d.BattingTeamID,
+				BowlingTeamID: d.BowlingTeamID,
+			})
+			pos = len(scores) - 1
+			index[d.Innings] = pos
+		}
+		score := &scores[pos]
+
+		score.Runs += d.RunsOffBat + d.Extras
+		if isLegalDelivery(d) {
+			score.LegalBalls++
+		}
+		if countsAsWicket(d) {
+			score.Wickets++
+		}
+
+		if d.ExtraType != nil {
+			switch *d.ExtraType {
+			case "wide":
+				score.Extras.Wides += d.Extras
+			case "no_ball":
+				score.Extras.NoBalls += d.Extras
+			case "bye":
+				score.Extras.Byes += d.Extras
+			case "leg_bye":
+				score.Extras.LegByes += d.Extras
+			case "penalty":
+				score.Extras.Penalty += d.Extras
+			}
+			score.Extras.Total += d.Extras
+		}
+	}
+
+	for i := range scores {
+		score := &scores[i]
+		score.Overs = formatOvers(score.LegalBalls)
+		if score.LegalBalls > 0 {
+			score.RunRate = float64(score.Runs) * 6 / float64(score.LegalBalls)
+		}
+
+		in, ok := limits[score.Innings]
+		if !ok {
+			in = legacyInnings(match, score.Innings, scores)
+			score.MaxOvers = in.MaxOvers
+			score.Target = in.Target
+		}
+		score.MaxWickets = in.MaxWickets
+
+		if score.Declared || score.Wickets >= in.MaxWickets {
+			score.IsComplete = true
+		}
+		if score.MaxOvers != nil && score.LegalBalls >= *score.MaxOvers*6 {
+			score.IsComplete = true
+		}
+		// A chase shortened more than the first innings keeps its run rate
+		if ok && score.Innings == 2 && !score.IsSuperOver && score.Target != nil {
+			if first, found := limits[1]; found {
+				firstOvers, chaseOvers := first.OversLimit(), in.OversLimit()
+				if firstOvers != nil && chaseOvers != nil && *chaseOvers < *firstOvers {
+					target := revisedTarget(scores[index[1]].Runs, *firstOvers, *chaseOvers)
+					score.Target = &target
+				}
+			}
+		}
+		// The chase ends as soon as the target is reached
+		if score.Target != nil && score.Runs >= *score.Target {
+			score.IsComplete = true
+		}
+	}
+
+	return scores
+}
+
+// legacyInnings gives the limits of an innings scored before innings were
+// recorded, when every match had at most two regular innings
+func legacyInnings(match *domain.Match, number int, scores []domain.InningsScore) domain.Innings {
+	in := domain.Innings{Number: number, MaxWickets: maxWickets}
+	if isLimitedOvers(match) {
+		if match.TotalOvers > 0 {
+			overs := match.TotalOvers
+			in.MaxOvers = &overs
+		}
+		if number == 2 && len(scores) > 0 && scores[0].Innings == 1 {
+			target := scores[0].Runs + 1
+			in.Target = &target
+		}
+	}
+	return in
+}
+
+// buildLiveScore derives the scoreboard, partnership and chase equation for a match
+func buildLiveScore(match *domain.Match, innings []domain.Innings, deliveries []domain.Delivery) *domain.LiveScore {
+	live := &domain.LiveScore{
+		MatchID:    match.ID,
+		Status:     match.Status,
+		TotalOvers: match.TotalOvers,
+		Innings:    summarizeInnings(match, innings, deliveries),
+	}
+	if live.Innings == nil {
+		live.Innings = []domain.InningsScore{}
+	}
+
+	if len(live.Innings) == 0 {
+		return live
+	}
+
+	current := live.Innings[len(live.Innings)-1]
+	live.Current = &current
+
+	// Chase equation for an innings with a target
+	if current.Target != nil {
+		runsRequired := *current.Target - current.Runs
+		if runsRequired < 0 {
+			runsRequired = 0
+		}
+		live.Target = current.Target
+		live.RunsRequired = &runsRequired
+
+		if current.MaxOvers != nil {
+			ballsRemaining := *current.MaxOvers*6 - current.LegalBalls
+			if ballsRemaining < 0 {
+				ballsRemaining = 0
+			}
+			live.BallsRemaining = &ballsRemaining
+			if ballsRemaining > 0 {
+				rrr := float64(runsRequired) * 6 / float64(ballsRemaining)
+				live.RequiredRunRate = &rrr
+			}
+		}
+	}
+
+	if len(deliveries) == 0 {
+		return live
+	}
+
+	last := deliveries[len(deliveries)-1]
+	live.LastDelivery = &last
+
+	// Current partnership: everything since the last wicket of the innings
+	inningsDeliveries := filterInnings(deliveries, current.Innings)
+	start := 0
+	for i, d := range inningsDeliveries {
+		if d.IsWicket {
+			start = i + 1
+		}
+	}
+	if start < len(inningsDeliveries) {
+		partnership := &domain.Partnership{
+			BatterAID: last.StrikerID,
+			BatterBID: last.NonStrikerID,
+		}
+		for _, d := range inningsDeliveries[start:] {
+			partnership.Runs += d.RunsOffBat + d.Extras
+			if d.ExtraType == nil || *d.ExtraType != "wide" {
+				partnership.Balls++
+			}
+		}
+		live.Partnership = partnership
+	}
+
+	return live
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/match/domain"
+	"github.com/google/uuid"
+)
+
+// Team role operations
+
+func (s *matchService) ListTeamRoles(ctx context.Context, teamID uuid.UUID) ([]domain.TeamRole, error) {
+	if _, err := s.repo.GetTeamByID(ctx, teamID); err != nil {
+		return nil, fmt.Errorf("team not found")
+	}
+
+	return s.repo.ListTeamRoles(ctx, teamID)
+}
+
+// AssignTeamRole lets the owner appoint managers, captains and vice-captains,
+// and managers appoint captains and vice-captains
+func (s *matchService) AssignTeamRole(ctx context.Context, teamID uuid.UUID, req domain.AssignTeamRoleRequest, userID uuid.UUID) (*domain.TeamRole, error) {
+	validRoles := map[string]bool{
+		domain.TeamRoleManager: true, domain.TeamRoleCaptain: true, domain.TeamRoleViceCaptain: true,
+	}
+	if !validRoles[req.Role] {
+		return nil, fmt.Errorf("role must be manager, captain or vice_captain")
+	}
+	if req.UserID == uuid.Nil {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	actorRole, err := s.requireTeamRole(ctx, teamID, userID, domain.TeamEditors, "assign team roles")
+	if err != nil {
+		return nil, err
+	}
+
+	currentRole, err := s.repo.GetTeamRole(ctx, teamID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if currentRole == domain.TeamRoleOwner {
+		return nil, fmt.Errorf("the owner's role can only change by transferring ownership")
+	}
+	if actorRole != domain.TeamRoleOwner && (req.Role == domain.TeamRoleManager || currentRole == domain.TeamRoleManager) {
+		return nil, fmt.Errorf("only the team owner can appoint or change managers")
+	}
+
+	// Captains lead on the field, so they must play for the team
+	if req.Role == domain.TeamRoleCaptain || req.Role == domain.TeamRoleViceCaptain {
+		member, err := s.repo.IsActiveTeamMember(ctx, teamID, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !member {
+			return nil, fmt.Errorf("captains and vice-captains must be players in the team")
+		}
+	}
+
+	role := &domain.TeamRole{
+		TeamID:     teamID,
+		UserID:     req.UserID,
+		Role:       req.Role,
+		AssignedBy: &userID,
+	}
+	if err := s.repo.AssignTeamRole(ctx, role); err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// RemoveTeamRole takes a role away. Members can always step down from their
+// own role, except the owner, who has to transfer ownership first.
+func (s *matchService) RemoveTeamRole(ctx context.Context, teamID, memberID uuid.UUID, userID uuid.UUID) error {
+	currentRole, err := s.repo.GetTeamRole(ctx, teamID, memberID)
+	if err != nil {
+		return err
+	}
+	if currentRole == "" {
+		return fmt.Errorf("user has no role in this team")
+	}
+	if currentRole == domain.TeamRoleOwner {
+		return fmt.Errorf("the owner must transfer ownership before stepping down")
+	}
+
+	if memberID != userID {
+		actorRole, err := s.requireTeamRole(ctx, teamID, userID, domain.TeamEditors, "remove team roles")
+		if err != nil {
+			return err
+		}
+		if actorRole != domain.TeamRoleOwner && currentRole == domain.TeamRoleManager {
+			return fmt.Errorf("only the team owner can remove managers")
+		}
+	}
+
+	return s.repo.RemoveTeamRole(ctx, teamID, memberID)
+}
+
+// TransferTeamOwnership hands the team to another user. The previous owner
+// stays on as a manager.
+func (s *matchService) TransferTeamOwnership(ctx context.Context, teamID uuid.UUID, req domain.TransferOwnershipRequest, userID uuid.UUID) error {
+	if req.UserID == uuid.Nil {
+		return fmt.Errorf("user_id is required")
+	}
+	if req.UserID == userID {
+		return fmt.Errorf("you already own this team")
+	}
+
+	if _, err := s.requireTeamRole(ctx, teamID, userID, []string{domain.TeamRoleOwner}, "transfer ownership"); err != nil {
+		return err
+	}
+
+	return s.repo.TransferTeamOwnership(ctx, teamID, userID, req.UserID)
+}
+
+// requireTeamRole checks that userID holds one of the allowed roles in the
+// team and returns the role they hold
+func (s *matchService) requireTeamRole(ctx context.Context, teamID, userID uuid.UUID, allowed []string, action string) (string, error) {
+	if _, err := s.repo.GetTeamByID(ctx, teamID); err != nil {
+		return "", fmt.Errorf("team not found")
+	}
+
+	role, err := s.repo.GetTeamRole(ctx, teamID, userID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, r := range allowed {
+		if role == r {
+			return role, nil
+		}
+	}
+
+	return "", fmt.Errorf("not authorized to %s for this team", action)
+}
+
+// canPickSquad reports whether userID may change a team's squad for a match:
+// the match's creator, or the team's owner, managers and captains
+func (s *matchService) canPickSquad(ctx context.Context, match *domain.Match, teamID, userID uuid.UUID) error {
+	if match.CreatedBy == userID {
+		return nil
+	}
+
+	_, err := s.requireTeamRole(ctx, teamID, userID, domain.TeamSquadPickers, "manage the squad")
+	return err
+}
@@ -0,0 +1,118 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/cricketapp/backend/internal/medical/domain"
+	"github.com/go-chi/chi/v5"
+)
+
+// ApplyAsPhysio handles POST /api/v1/physiotherapists/apply
+func (h *MedicalHandler) ApplyAsPhysio(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	role, _ := ctx.Value("user_role").(string)
+
+	var req domain.PhysioApplicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	application, err := h.service.ApplyAsPhysio(ctx, userID, role, &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(application)
+}
+
+// GetMyApplication handles GET /api/v1/physiotherapists/application
+func (h *MedicalHandler) GetMyApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	application, err := h.service.GetMyApplication(ctx, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(application)
+}
+
+// ListApplications handles GET /api/v1/admin/physio-applications?status=pending
+func (h *MedicalHandler) ListApplications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if _, ok := ctx.Value("user_id").(string); !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	role, _ := ctx.Value("user_role").(string)
+
+	applications, err := h.service.ListApplications(ctx, role, r.URL.Query().Get("status"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(applications)
+}
+
+// ApproveApplication handles POST /api/v1/admin/physio-applications/:id/approve
+func (h *MedicalHandler) ApproveApplication(w http.ResponseWriter, r *http.Request) {
+	h.reviewApplication(w, r, h.service.ApproveApplication)
+}
+
+// RejectApplication handles POST /api/v1/admin/physio-applications/:id/reject
+func (h *MedicalHandler) RejectApplication(w http.ResponseWriter, r *http.Request) {
+	h.reviewApplication(w, r, h.service.RejectApplication)
+}
+
+type reviewFunc func(ctx context.Context, adminID, role, physioID string, req *domain.ReviewApplicationRequest) (*domain.PhysioApplication, error)
+
+func (h *MedicalHandler) reviewApplication(w http.ResponseWriter, r *http.Request, review reviewFunc) {
+	ctx := r.Context()
+
+	adminID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	role, _ := ctx.Value("user_role").(string)
+
+	var req domain.ReviewApplicationRequest
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+	}
+
+	application, err := review(ctx, adminID, role, chi.URLParam(r, "id"), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(application)
+}
@@ -0,0 +1,134 @@
+package domain
+
+import "time"
+
+// Injury is an injury recorded against a team player
+type Injury struct {
+	ID                 string    `json:"id"`
+	PlayerID           string    `json:"player_id"`
+	PlayerName         string    `json:"player_name,omitempty"`
+	BodyPart           string    `json:"body_part"`
+	Severity           string    `json:"severity"`                       // minor, moderate, severe
+	InjuryDate         string    `json:"injury_date"`                    // YYYY-MM-DD
+	ExpectedReturnDate *string   `json:"expected_return_date,omitempty"` // YYYY-MM-DD
+	Status             string    `json:"status"`                         // active, recovering, recovered
+	Description        string    `json:"description,omitempty"`
+	ReportedBy         string    `json:"reported_by,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+
+	RehabPlans []RehabPlan `json:"rehab_plans,omitempty"`
+}
+
+// Injury statuses
+const (
+	InjuryActive     = "active"
+	InjuryRecovering = "recovering"
+	InjuryRecovered  = "recovered"
+)
+
+// Injury severities
+var ValidSeverities = map[string]bool{
+	"minor":    true,
+	"moderate": true,
+	"severe":   true,
+}
+
+// RehabPlan is a physiotherapist's recovery plan for an injury
+type RehabPlan struct {
+	ID                string         `json:"id"`
+	InjuryID          string         `json:"injury_id"`
+	PhysiotherapistID string         `json:"physiotherapist_id"`
+	Title             string         `json:"title"`
+	Goals             string         `json:"goals,omitempty"`
+	StartDate         string         `json:"start_date"`         // YYYY-MM-DD
+	EndDate           *string        `json:"end_date,omitempty"` // YYYY-MM-DD
+	Status            string         `json:"status"`             // active, completed
+	Sessions          []RehabSession `json:"sessions"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+}
+
+// Rehab plan statuses
+const (
+	RehabActive    = "active"
+	RehabCompleted = "completed"
+)
+
+// RehabSession is a physio appointment counted towards a rehab plan
+type RehabSession struct {
+	ID                string    `json:"id"`
+	PlanID            string    `json:"plan_id"`
+	AppointmentID     string    `json:"appointment_id"`
+	SessionDate       string    `json:"session_date"` // YYYY-MM-DD, from the appointment
+	SessionTime       string    `json:"session_time"` // HH:MM, from the appointment
+	AppointmentStatus string    `json:"appointment_status"`
+	Notes             string    `json:"notes,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// TeamPlayer is a player as seen by the medical package, with the users who manage their team
+type TeamPlayer struct {
+	ID           string   `json:"player_id"`
+	UserID       string   `json:"user_id"`
+	TeamID       string   `json:"team_id"`
+	FullName     string   `json:"full_name"`
+	JerseyNumber int      `json:"jersey_number"`
+	Role         string   `json:"role"`
+	TeamStaff    []string `json:"-"` // Owner, managers and captain of the team
+}
+
+// Player availability statuses
+const (
+	PlayerAvailable   = "available"
+	PlayerDoubtful    = "doubtful"
+	PlayerUnavailable = "unavailable"
+)
+
+// PlayerAvailability is a player's fitness in a team availability report
+type PlayerAvailability struct {
+	TeamPlayer
+	Status string  `json:"status"` // available, doubtful, unavailable
+	Injury *Injury `json:"injury,omitempty"`
+}
+
+// AvailabilityReport lists the fitness of every active player in a team
+type AvailabilityReport struct {
+	TeamID      string               `json:"team_id"`
+	Date        string               `json:"date"` // YYYY-MM-DD
+	Available   int                  `json:"available"`
+	Doubtful    int                  `json:"doubtful"`
+	Unavailable int                  `json:"unavailable"`
+	Players     []PlayerAvailability `json:"players"`
+}
+
+// ReportInjuryRequest records a new injury
+type ReportInjuryRequest struct {
+	BodyPart           string  `json:"body_part"`
+	Severity           string  `json:"severity"`
+	InjuryDate         string  `json:"injury_date"` // YYYY-MM-DD
+	ExpectedReturnDate *string `json:"expected_return_date,omitempty"`
+	Description        string  `json:"description,omitempty"`
+}
+
+// UpdateInjuryRequest changes an injury's progress; omitted fields are kept
+type UpdateInjuryRequest struct {
+	Severity           *string `json:"severity,omitempty"`
+	ExpectedReturnDate *string `json:"expected_return_date,omitempty"`
+	Status             *string `json:"status,omitempty"`
+	Description        *string `json:"description,omitempty"`
+}
+
+// CreateRehabPlanRequest starts a rehab plan for an injury
+type CreateRehabPlanRequest struct {
+	Title     string  `json:"title"`
+	Goals     string  `json:"goals,omitempty"`
+	StartDate string  `json:"start_date"` // YYYY-MM-DD
+	EndDate   *string `json:"end_date,omitempty"`
+}
+
+// AddRehabSessionRequest counts a physio appointment towards a rehab plan
+type AddRehabSessionRequest struct {
+	AppointmentID string `json:"appointment_id"`
+	Notes         string `json:"notes,omitempty"`
+}
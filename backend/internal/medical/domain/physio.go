@@ -16,6 +16,7 @@ type Physiotherapist struct {
 	ConsultationFee float64   `json:"consultation_fee"`
 	AvailableDays   []string  `json:"available_days"`
 	AvailableHours  string    `json:"available_hours"`
+	SlotMinutes     int       `json:"slot_minutes"`
 	Rating          float64   `json:"rating"`
 	TotalReviews    int       `json:"total_reviews"`
 	IsVerified      bool      `json:"is_verified"`
@@ -25,6 +26,42 @@ type Physiotherapist struct {
 	UpdatedAt       time.Time `json:"updated_at"`
 }
 
+// PhysioApplication is a physiotherapist profile as submitted for verification
+type PhysioApplication struct {
+	Physiotherapist
+	VerificationStatus string     `json:"verification_status"` // pending, approved, rejected
+	Documents          []string   `json:"documents"`
+	RejectionReason    string     `json:"rejection_reason,omitempty"`
+	ReviewedBy         string     `json:"reviewed_by,omitempty"`
+	ReviewedAt         *time.Time `json:"reviewed_at,omitempty"`
+	SubmittedAt        time.Time  `json:"submitted_at"`
+}
+
+// Verification statuses of a physiotherapist application
+const (
+	VerificationPending  = "pending"
+	VerificationApproved = "approved"
+	VerificationRejected = "rejected"
+)
+
+// PhysioApplicationRequest represents a physio user's application, or a
+// resubmission after it was rejected
+type PhysioApplicationRequest struct {
+	Specialization  string   `json:"specialization"`
+	ExperienceYears int      `json:"experience_years"`
+	Qualifications  []string `json:"qualifications"`
+	ClinicName      string   `json:"clinic_name,omitempty"`
+	ClinicAddress   string   `json:"clinic_address"`
+	ConsultationFee float64  `json:"consultation_fee"`
+	Bio             string   `json:"bio,omitempty"`
+	Documents       []string `json:"documents"` // URLs of certificates and licences
+}
+
+// ReviewApplicationRequest carries an admin's reason for a decision
+type ReviewApplicationRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
 // Appointment represents a patient appointment with a physiotherapist
 type Appointment struct {
 	ID                string    `json:"id"`
@@ -33,15 +70,54 @@ type Appointment struct {
 	AppointmentDate   string    `json:"appointment_date"` // YYYY-MM-DD
 	AppointmentTime   string    `json:"appointment_time"` // HH:MM
 	DurationMinutes   int       `json:"duration_minutes"`
-	Status            string    `json:"status"` // scheduled, completed, cancelled, rescheduled
+	Status            string    `json:"status"` // scheduled, completed, cancelled, rescheduled, no_show
 	Complaint         string    `json:"complaint,omitempty"`
-	Notes             string    `json:"notes,omitempty"`
+	Notes             string    `json:"notes,omitempty"` // Clinical notes from the physiotherapist
 	Fee               float64   `json:"fee"`
 	PaymentStatus     string    `json:"payment_status"` // pending, paid, refunded
+	RescheduledFrom   string    `json:"rescheduled_from,omitempty"`
+	PatientName       string    `json:"patient_name,omitempty"`
 	CreatedAt         time.Time `json:"created_at"`
 	UpdatedAt         time.Time `json:"updated_at"`
 }
 
+// Appointment statuses
+const (
+	AppointmentScheduled   = "scheduled"
+	AppointmentCompleted   = "completed"
+	AppointmentCancelled   = "cancelled"
+	AppointmentRescheduled = "rescheduled"
+	AppointmentNoShow      = "no_show"
+)
+
+// StatusChange is an entry in an appointment's status history
+type StatusChange struct {
+	ID            string    `json:"id"`
+	AppointmentID string    `json:"appointment_id"`
+	FromStatus    string    `json:"from_status,omitempty"`
+	ToStatus      string    `json:"to_status"`
+	ChangedBy     string    `json:"changed_by,omitempty"`
+	Reason        string    `json:"reason,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// CompleteAppointmentRequest carries the physiotherapist's clinical notes
+type CompleteAppointmentRequest struct {
+	Notes string `json:"notes"`
+}
+
+// CancelAppointmentRequest carries the optional reason for a cancellation or no-show
+type CancelAppointmentRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// RescheduleAppointmentRequest moves an appointment to a new slot
+type RescheduleAppointmentRequest struct {
+	AppointmentDate string `json:"appointment_date"` // YYYY-MM-DD
+	AppointmentTime string `json:"appointment_time"` // HH:MM
+	Reason          string `json:"reason,omitempty"`
+}
+
 // CreateAppointmentRequest represents appointment booking request
 type CreateAppointmentRequest struct {
 	PhysiotherapistID string `json:"physiotherapist_id"`
@@ -50,6 +126,63 @@ type CreateAppointmentRequest struct {
 	Complaint         string `json:"complaint"`
 }
 
+// WorkingHours is a physiotherapist's working day, with an optional break
+type WorkingHours struct {
+	DayOfWeek  int     `json:"day_of_week"` // 0 = Sunday
+	Day        string  `json:"day"`         // Day name, filled in on read
+	StartTime  string  `json:"start_time"`  // HH:MM
+	EndTime    string  `json:"end_time"`    // HH:MM
+	BreakStart *string `json:"break_start,omitempty"`
+	BreakEnd   *string `json:"break_end,omitempty"`
+}
+
+// LeaveDay is a date on which a physiotherapist takes no appointments
+type LeaveDay struct {
+	ID                string    `json:"id"`
+	PhysiotherapistID string    `json:"physiotherapist_id"`
+	Date              string    `json:"date"` // YYYY-MM-DD
+	Reason            string    `json:"reason,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// Schedule is a physiotherapist's weekly hours and upcoming leave
+type Schedule struct {
+	PhysiotherapistID string         `json:"physiotherapist_id"`
+	SlotMinutes       int            `json:"slot_minutes"`
+	WorkingHours      []WorkingHours `json:"working_hours"`
+	LeaveDays         []LeaveDay     `json:"leave_days"`
+}
+
+// UpdateScheduleRequest replaces a physiotherapist's weekly hours
+type UpdateScheduleRequest struct {
+	SlotMinutes  int            `json:"slot_minutes"`
+	WorkingHours []WorkingHours `json:"working_hours"`
+}
+
+// AddLeaveRequest represents a request to block a date
+type AddLeaveRequest struct {
+	Date   string `json:"date"` // YYYY-MM-DD
+	Reason string `json:"reason,omitempty"`
+}
+
+// Slot is an appointment window on a given day
+type Slot struct {
+	StartTime string `json:"start_time"` // HH:MM
+	EndTime   string `json:"end_time"`   // HH:MM
+	Status    string `json:"status"`     // free, booked, past
+}
+
+// DaySlots lists a physiotherapist's appointment slots for one day
+type DaySlots struct {
+	PhysiotherapistID string `json:"physiotherapist_id"`
+	Date              string `json:"date"`
+	IsWorkingDay      bool   `json:"is_working_day"`
+	IsLeave           bool   `json:"is_leave"`
+	LeaveReason       string `json:"leave_reason,omitempty"`
+	SlotMinutes       int    `json:"slot_minutes"`
+	Slots             []Slot `json:"slots"`
+}
+
 // PhysioListResponse represents paginated physiotherapist list
 type PhysioListResponse struct {
 	Physiotherapists []Physiotherapist `json:"physiotherapists"`
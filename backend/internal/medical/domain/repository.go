@@ -6,7 +6,41 @@ import "context"
 type MedicalRepository interface {
 	ListPhysiotherapists(ctx context.Context, page, limit int) ([]Physiotherapist, int, error)
 	GetPhysiotherapistByID(ctx context.Context, physioID string) (*Physiotherapist, error)
+	GetPhysiotherapistByUserID(ctx context.Context, userID string) (*Physiotherapist, error)
+	SavePhysioApplication(ctx context.Context, application *PhysioApplication) error
+	GetPhysioApplication(ctx context.Context, physioID string) (*PhysioApplication, error)
+	GetPhysioApplicationByUserID(ctx context.Context, userID string) (*PhysioApplication, error)
+	ListPhysioApplications(ctx context.Context, status string) ([]PhysioApplication, error)
+	ReviewPhysioApplication(ctx context.Context, application *PhysioApplication) error
 	CreateAppointment(ctx context.Context, appointment *Appointment) error
 	GetAppointmentsByPatient(ctx context.Context, patientID string) ([]Appointment, error)
 	GetAppointmentByID(ctx context.Context, appointmentID string) (*Appointment, error)
+	ListActiveAppointments(ctx context.Context, physioID, date string) ([]Appointment, error)
+	ListPhysioAppointments(ctx context.Context, physioID, status, from string) ([]Appointment, error)
+	UpdateAppointmentStatus(ctx context.Context, appointment *Appointment, fromStatus, changedBy, reason string) error
+	RescheduleAppointment(ctx context.Context, old, replacement *Appointment, changedBy, reason string) error
+	GetStatusHistory(ctx context.Context, appointmentID string) ([]StatusChange, error)
+
+	// Schedule operations
+	GetWorkingHours(ctx context.Context, physioID string) ([]WorkingHours, error)
+	ReplaceSchedule(ctx context.Context, physioID string, slotMinutes int, hours []WorkingHours) error
+	ListLeaveDays(ctx context.Context, physioID, from, to string) ([]LeaveDay, error)
+	AddLeaveDay(ctx context.Context, leave *LeaveDay) error
+	DeleteLeaveDay(ctx context.Context, physioID, leaveID string) error
+
+	// Injury operations
+	GetTeamPlayer(ctx context.Context, playerID string) (*TeamPlayer, error)
+	ListTeamPlayers(ctx context.Context, teamID string) ([]TeamPlayer, error)
+	GetTeamStaff(ctx context.Context, teamID string) ([]string, error)
+	IsTreatingPlayer(ctx context.Context, physioID, playerID string) (bool, error)
+	IsTreatingTeam(ctx context.Context, physioID, teamID string) (bool, error)
+	CreateInjury(ctx context.Context, injury *Injury) error
+	GetInjuryByID(ctx context.Context, injuryID string) (*Injury, error)
+	UpdateInjury(ctx context.Context, injury *Injury) error
+	ListPlayerInjuries(ctx context.Context, playerID string) ([]Injury, error)
+	ListOpenInjuriesByTeam(ctx context.Context, teamID string) ([]Injury, error)
+	CreateRehabPlan(ctx context.Context, plan *RehabPlan) error
+	GetRehabPlanByID(ctx context.Context, planID string) (*RehabPlan, error)
+	ListRehabPlans(ctx context.Context, injuryID string) ([]RehabPlan, error)
+	AddRehabSession(ctx context.Context, session *RehabSession) error
 }
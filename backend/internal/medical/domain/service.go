@@ -6,6 +6,32 @@ import "context"
 type MedicalService interface {
 	ListPhysiotherapists(ctx context.Context, page, limit int) (*PhysioListResponse, error)
 	GetPhysiotherapistDetails(ctx context.Context, physioID string) (*Physiotherapist, error)
+	ApplyAsPhysio(ctx context.Context, userID, role string, req *PhysioApplicationRequest) (*PhysioApplication, error)
+	GetMyApplication(ctx context.Context, userID string) (*PhysioApplication, error)
+	ListApplications(ctx context.Context, role, status string) ([]PhysioApplication, error)
+	ApproveApplication(ctx context.Context, adminID, role, physioID string, req *ReviewApplicationRequest) (*PhysioApplication, error)
+	RejectApplication(ctx context.Context, adminID, role, physioID string, req *ReviewApplicationRequest) (*PhysioApplication, error)
 	CreateAppointment(ctx context.Context, patientID string, req *CreateAppointmentRequest) (*Appointment, error)
 	GetPatientAppointments(ctx context.Context, patientID string) ([]Appointment, error)
+	GetPhysioAppointments(ctx context.Context, userID, status string) ([]Appointment, error)
+	CompleteAppointment(ctx context.Context, userID, appointmentID string, req *CompleteAppointmentRequest) (*Appointment, error)
+	MarkNoShow(ctx context.Context, userID, appointmentID string, req *CancelAppointmentRequest) (*Appointment, error)
+	CancelAppointment(ctx context.Context, userID, appointmentID string, req *CancelAppointmentRequest) (*Appointment, error)
+	RescheduleAppointment(ctx context.Context, userID, appointmentID string, req *RescheduleAppointmentRequest) (*Appointment, error)
+	GetAppointmentHistory(ctx context.Context, userID, appointmentID string) ([]StatusChange, error)
+
+	GetSchedule(ctx context.Context, physioID string) (*Schedule, error)
+	UpdateSchedule(ctx context.Context, userID, physioID string, req *UpdateScheduleRequest) (*Schedule, error)
+	AddLeaveDay(ctx context.Context, userID, physioID string, req *AddLeaveRequest) (*LeaveDay, error)
+	DeleteLeaveDay(ctx context.Context, userID, physioID, leaveID string) error
+	GetSlots(ctx context.Context, physioID, date string) (*DaySlots, error)
+
+	ReportInjury(ctx context.Context, userID, playerID string, req *ReportInjuryRequest) (*Injury, error)
+	GetPlayerInjuries(ctx context.Context, userID, playerID string) ([]Injury, error)
+	GetInjury(ctx context.Context, userID, injuryID string) (*Injury, error)
+	UpdateInjury(ctx context.Context, userID, injuryID string, req *UpdateInjuryRequest) (*Injury, error)
+	CreateRehabPlan(ctx context.Context, userID, injuryID string, req *CreateRehabPlanRequest) (*RehabPlan, error)
+	AddRehabSession(ctx context.Context, userID, planID string, req *AddRehabSessionRequest) (*RehabSession, error)
+	GetTeamAvailability(ctx context.Context, userID, teamID, date string) (*AvailabilityReport, error)
+	FitnessWarning(ctx context.Context, playerID, date string) (string, error)
 }
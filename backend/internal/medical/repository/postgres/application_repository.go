@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/medical/domain"
+	"github.com/lib/pq"
+)
+
+// applicationColumns follows physioColumns with the verification details of an application
+const applicationColumns = physioColumns + `,
+	p.verification_status, p.documents, p.rejection_reason, p.reviewed_by, p.reviewed_at, p.submitted_at`
+
+func scanApplication(row scanner, a *domain.PhysioApplication) error {
+	var rejectionReason, reviewedBy sql.NullString
+	var reviewedAt sql.NullTime
+
+	err := scanPhysio(row, &a.Physiotherapist,
+		&a.VerificationStatus, pq.Array(&a.Documents), &rejectionReason, &reviewedBy, &reviewedAt, &a.SubmittedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	a.RejectionReason = rejectionReason.String
+	a.ReviewedBy = reviewedBy.String
+	if reviewedAt.Valid {
+		a.ReviewedAt = &reviewedAt.Time
+	}
+
+	return nil
+}
+
+// SavePhysioApplication creates the user's physiotherapist profile, or
+// replaces it when an earlier application is still pending or was rejected.
+// Either way the application goes back into the review queue.
+func (r *medicalRepository) SavePhysioApplication(ctx context.Context, application *domain.PhysioApplication) error {
+	query := `
+		INSERT INTO physiotherapists (
+			user_id, specialization, experience_years, qualifications, clinic_name, clinic_address,
+			consultation_fee, bio, documents, available_days, available_hours,
+			is_verified, verification_status, submitted_at
+		) VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, NULLIF($8, ''), $9, '{}', '', false, 'pending', CURRENT_TIMESTAMP)
+		ON CONFLICT (user_id) DO UPDATE SET
+			specialization = EXCLUDED.specialization,
+			experience_years = EXCLUDED.experience_years,
+			qualifications = EXCLUDED.qualifications,
+			clinic_name = EXCLUDED.clinic_name,
+			clinic_address = EXCLUDED.clinic_address,
+			consultation_fee = EXCLUDED.consultation_fee,
+			bio = EXCLUDED.bio,
+			documents = EXCLUDED.documents,
+			verification_status = 'pending',
+			rejection_reason = NULL,
+			reviewed_by = NULL,
+			reviewed_at = NULL,
+			submitted_at = CURRENT_TIMESTAMP,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE physiotherapists.verification_status <> 'approved'
+		RETURNING id
+	`
+
+	p := application.Physiotherapist
+	err := r.db.QueryRowContext(ctx, query,
+		p.UserID, p.Specialization, p.ExperienceYears, pq.Array(p.Qualifications), p.ClinicName, p.ClinicAddress,
+		p.ConsultationFee, p.Bio, pq.Array(application.Documents),
+	).Scan(&application.ID)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("physiotherapist is already verified")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save application: %w", err)
+	}
+
+	saved, err := r.GetPhysioApplication(ctx, application.ID)
+	if err != nil {
+		return err
+	}
+	*application = *saved
+
+	return nil
+}
+
+func (r *medicalRepository) GetPhysioApplication(ctx context.Context, physioID string) (*domain.PhysioApplication, error) {
+	return r.getApplication(ctx, "p.id", physioID)
+}
+
+func (r *medicalRepository) GetPhysioApplicationByUserID(ctx context.Context, userID string) (*domain.PhysioApplication, error) {
+	return r.getApplication(ctx, "p.user_id", userID)
+}
+
+func (r *medicalRepository) getApplication(ctx context.Context, column, value string) (*domain.PhysioApplication, error) {
+	query := `
+		SELECT ` + applicationColumns + `
+		FROM physiotherapists p
+		JOIN users u ON p.user_id = u.id
+		WHERE ` + column + ` = $1
+	`
+
+	var a domain.PhysioApplication
+	err := scanApplication(r.db.QueryRowContext(ctx, query, value), &a)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("application not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application: %w", err)
+	}
+
+	return &a, nil
+}
+
+// ListPhysioApplications returns applications with the given status, oldest first
+func (r *medicalRepository) ListPhysioApplications(ctx context.Context, status string) ([]domain.PhysioApplication, error) {
+	query := `
+		SELECT ` + applicationColumns + `
+		FROM physiotherapists p
+		JOIN users u ON p.user_id = u.id
+		WHERE p.verification_status = $1
+		ORDER BY p.submitted_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	defer rows.Close()
+
+	applications := []domain.PhysioApplication{}
+	for rows.Next() {
+		var a domain.PhysioApplication
+		if err := scanApplication(rows, &a); err != nil {
+			return nil, fmt.Errorf("failed to scan application: %w", err)
+		}
+		applications = append(applications, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return applications, nil
+}
+
+// ReviewPhysioApplication records an admin's decision on a pending application
+func (r *medicalRepository) ReviewPhysioApplication(ctx context.Context, application *domain.PhysioApplication) error {
+	query := `
+		UPDATE physiotherapists
+		SET verification_status = $1, is_verified = ($1 = 'approved'), rejection_reason = NULLIF($2, ''),
+		    reviewed_by = $3, reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4 AND verification_status = 'pending'
+		RETURNING is_verified, reviewed_at, updated_at
+	`
+
+	var reviewedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query,
+		application.VerificationStatus, application.RejectionReason, application.ReviewedBy, application.ID,
+	).Scan(&application.IsVerified, &reviewedAt, &application.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("application is no longer pending")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to review application: %w", err)
+	}
+	if reviewedAt.Valid {
+		application.ReviewedAt = &reviewedAt.Time
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/medical/domain"
+)
+
+func (r *medicalRepository) ListPhysioAppointments(ctx context.Context, physioID, status, from string) ([]domain.Appointment, error) {
+	query := `
+		SELECT ` + appointmentColumns + `, u.full_name
+		FROM appointments a
+		JOIN users u ON u.id = a.patient_id
+		WHERE a.physiotherapist_id = $1
+		  AND ($2 = '' OR a.status = $2)
+		  AND a.appointment_date >= $3
+		ORDER BY a.appointment_date ASC, a.appointment_time ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, physioID, status, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appointments: %w", err)
+	}
+	defer rows.Close()
+
+	appointments := []domain.Appointment{}
+	for rows.Next() {
+		var a domain.Appointment
+		if err := scanAppointment(rows, &a, &a.PatientName); err != nil {
+			return nil, fmt.Errorf("failed to scan appointment: %w", err)
+		}
+		appointments = append(appointments, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return appointments, nil
+}
+
+// UpdateAppointmentStatus saves the status, notes and payment status of an
+// appointment, provided it is still in fromStatus, and records the change
+func (r *medicalRepository) UpdateAppointmentStatus(ctx context.Context, appointment *domain.Appointment, fromStatus, changedBy, reason string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE appointments
+		SET status = $1, notes = NULLIF($2, ''), payment_status = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4 AND status = $5
+		RETURNING updated_at
+	`, appointment.Status, appointment.Notes, appointment.PaymentStatus, appointment.ID, fromStatus,
+	).Scan(&appointment.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("appointment is no longer %s", fromStatus)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update appointment: %w", err)
+	}
+
+	if err := recordStatusChange(ctx, tx, appointment.ID, fromStatus, appointment.Status, changedBy, reason); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RescheduleAppointment marks old as rescheduled and books replacement in its
+// place in one transaction, so the patient never loses the old slot without
+// getting the new one
+func (r *medicalRepository) RescheduleAppointment(ctx context.Context, old, replacement *domain.Appointment, changedBy, reason string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE appointments
+		SET status = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND status = $3
+		RETURNING updated_at
+	`, domain.AppointmentRescheduled, old.ID, domain.AppointmentScheduled).Scan(&old.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("appointment is no longer %s", domain.AppointmentScheduled)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update appointment: %w", err)
+	}
+	old.Status = domain.AppointmentRescheduled
+
+	if err := recordStatusChange(ctx, tx, old.ID, domain.AppointmentScheduled, domain.AppointmentRescheduled, changedBy, reason); err != nil {
+		return err
+	}
+
+	if err := insertAppointment(ctx, tx, replacement); err != nil {
+		return err
+	}
+
+	if err := recordStatusChange(ctx, tx, replacement.ID, "", replacement.Status, changedBy, reason); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *medicalRepository) GetStatusHistory(ctx context.Context, appointmentID string) ([]domain.StatusChange, error) {
+	query := `
+		SELECT id, appointment_id, from_status, to_status, changed_by, reason, created_at
+		FROM appointment_status_history
+		WHERE appointment_id = $1
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, appointmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status history: %w", err)
+	}
+	defer rows.Close()
+
+	history := []domain.StatusChange{}
+	for rows.Next() {
+		var c domain.StatusChange
+		var fromStatus, changedBy, reason sql.NullString
+		if err := rows.Scan(&c.ID, &c.AppointmentID, &fromStatus, &c.ToStatus, &changedBy, &reason, &c.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan status change: %w", err)
+		}
+		c.FromStatus = fromStatus.String
+		c.ChangedBy = changedBy.String
+		c.Reason = reason.String
+		history = append(history, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return history, nil
+}
+
+func recordStatusChange(ctx context.Context, tx *sql.Tx, appointmentID, fromStatus, toStatus, changedBy, reason string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO appointment_status_history (appointment_id, from_status, to_status, changed_by, reason)
+		VALUES ($1, NULLIF($2, ''), $3, NULLIF($4, '')::uuid, NULLIF($5, ''))
+	`, appointmentID, fromStatus, toStatus, changedBy, reason)
+	if err != nil {
+		return fmt.Errorf("failed to record status change: %w", err)
+	}
+	return nil
+}
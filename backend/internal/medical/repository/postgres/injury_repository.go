@@ -0,0 +1,412 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/medical/domain"
+	"github.com/lib/pq"
+)
+
+// injuryColumns selects an injury joined with its player's user, in the order read by scanInjury
+const injuryColumns = `
+	i.id, i.player_id, u.full_name, i.body_part, i.severity,
+	to_char(i.injury_date, 'YYYY-MM-DD'), to_char(i.expected_return_date, 'YYYY-MM-DD'),
+	i.status, i.description, i.reported_by, i.created_at, i.updated_at`
+
+const injuryJoins = `
+	FROM player_injuries i
+	JOIN players pl ON pl.id = i.player_id
+	JOIN users u ON u.id = pl.user_id`
+
+// teamPlayerColumns selects a player joined with its user and team, in the order read by scanTeamPlayer
+const teamPlayerColumns = `
+	pl.id, pl.user_id, pl.team_id, u.full_name, pl.jersey_number, pl.role,
+	ARRAY(SELECT tr.user_id::text FROM team_roles tr WHERE tr.team_id = pl.team_id AND ` + staffRoles + `)`
+
+// staffRoles restricts team_roles to the roles that manage a team's players
+const staffRoles = `tr.role IN ('owner', 'manager', 'captain')`
+
+// treatsPlayer holds when physiotherapist $1 is treating player pl: they have
+// a scheduled or completed appointment with the player, or a rehab plan for
+// one of the player's injuries
+const treatsPlayer = `(
+	EXISTS (
+		SELECT 1 FROM appointments a
+		WHERE a.physiotherapist_id = $1 AND a.patient_id = pl.user_id AND a.status IN ('scheduled', 'completed')
+	) OR EXISTS (
+		SELECT 1 FROM rehab_plans rp
+		JOIN player_injuries pi ON pi.id = rp.injury_id
+		WHERE rp.physiotherapist_id = $1 AND pi.player_id = pl.id
+	))`
+
+func scanInjury(row scanner, i *domain.Injury) error {
+	var expectedReturn, description, reportedBy sql.NullString
+
+	err := row.Scan(
+		&i.ID, &i.PlayerID, &i.PlayerName, &i.BodyPart, &i.Severity,
+		&i.InjuryDate, &expectedReturn,
+		&i.Status, &description, &reportedBy, &i.CreatedAt, &i.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if expectedReturn.Valid {
+		i.ExpectedReturnDate = &expectedReturn.String
+	}
+	i.Description = description.String
+	i.ReportedBy = reportedBy.String
+
+	return nil
+}
+
+func scanTeamPlayer(row scanner, p *domain.TeamPlayer) error {
+	return row.Scan(
+		&p.ID, &p.UserID, &p.TeamID, &p.FullName, &p.JerseyNumber, &p.Role,
+		pq.Array(&p.TeamStaff),
+	)
+}
+
+func (r *medicalRepository) GetTeamPlayer(ctx context.Context, playerID string) (*domain.TeamPlayer, error) {
+	query := `
+		SELECT ` + teamPlayerColumns + `
+		FROM players pl
+		JOIN users u ON u.id = pl.user_id
+		JOIN teams t ON t.id = pl.team_id
+		WHERE pl.id = $1
+	`
+
+	var p domain.TeamPlayer
+	err := scanTeamPlayer(r.db.QueryRowContext(ctx, query, playerID), &p)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("player not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player: %w", err)
+	}
+
+	return &p, nil
+}
+
+func (r *medicalRepository) ListTeamPlayers(ctx context.Context, teamID string) ([]domain.TeamPlayer, error) {
+	query := `
+		SELECT ` + teamPlayerColumns + `
+		FROM players pl
+		JOIN users u ON u.id = pl.user_id
+		JOIN teams t ON t.id = pl.team_id
+		WHERE pl.team_id = $1 AND pl.is_active = true
+		ORDER BY pl.jersey_number ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list players: %w", err)
+	}
+	defer rows.Close()
+
+	var players []domain.TeamPlayer
+	for rows.Next() {
+		var p domain.TeamPlayer
+		if err := scanTeamPlayer(rows, &p); err != nil {
+			return nil, fmt.Errorf("failed to scan player: %w", err)
+		}
+		players = append(players, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return players, nil
+}
+
+func (r *medicalRepository) GetTeamStaff(ctx context.Context, teamID string) ([]string, error) {
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM teams WHERE id = $1)", teamID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("team not found")
+	}
+
+	var staff []string
+	err := r.db.QueryRowContext(ctx,
+		"SELECT ARRAY(SELECT tr.user_id::text FROM team_roles tr WHERE tr.team_id = $1 AND "+staffRoles+")", teamID,
+	).Scan(pq.Array(&staff))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team staff: %w", err)
+	}
+
+	return staff, nil
+}
+
+func (r *medicalRepository) IsTreatingPlayer(ctx context.Context, physioID, playerID string) (bool, error) {
+	return r.isTreating(ctx, "SELECT EXISTS (SELECT 1 FROM players pl WHERE pl.id = $2 AND "+treatsPlayer+")", physioID, playerID)
+}
+
+func (r *medicalRepository) IsTreatingTeam(ctx context.Context, physioID, teamID string) (bool, error) {
+	return r.isTreating(ctx, "SELECT EXISTS (SELECT 1 FROM players pl WHERE pl.team_id = $2 AND pl.is_active = true AND "+treatsPlayer+")", physioID, teamID)
+}
+
+func (r *medicalRepository) isTreating(ctx context.Context, query, physioID, id string) (bool, error) {
+	var treating bool
+	if err := r.db.QueryRowContext(ctx, query, physioID, id).Scan(&treating); err != nil {
+		return false, fmt.Errorf("failed to check treating physiotherapist: %w", err)
+	}
+	return treating, nil
+}
+
+func (r *medicalRepository) CreateInjury(ctx context.Context, injury *domain.Injury) error {
+	query := `
+		INSERT INTO player_injuries (
+			player_id, body_part, severity, injury_date, expected_return_date,
+			status, description, reported_by
+		) VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		injury.PlayerID, injury.BodyPart, injury.Severity, injury.InjuryDate, injury.ExpectedReturnDate,
+		injury.Status, injury.Description, injury.ReportedBy,
+	).Scan(&injury.ID, &injury.CreatedAt, &injury.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create injury: %w", err)
+	}
+
+	return nil
+}
+
+func (r *medicalRepository) GetInjuryByID(ctx context.Context, injuryID string) (*domain.Injury, error) {
+	query := `SELECT ` + injuryColumns + injuryJoins + ` WHERE i.id = $1`
+
+	var i domain.Injury
+	err := scanInjury(r.db.QueryRowContext(ctx, query, injuryID), &i)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("injury not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get injury: %w", err)
+	}
+
+	return &i, nil
+}
+
+// UpdateInjury saves an injury's progress. Once the player has recovered any
+// rehab plans still running for the injury are completed with it.
+func (r *medicalRepository) UpdateInjury(ctx context.Context, injury *domain.Injury) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE player_injuries
+		SET severity = $1, expected_return_date = $2, status = $3, description = NULLIF($4, ''),
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $5
+		RETURNING updated_at
+	`, injury.Severity, injury.ExpectedReturnDate, injury.Status, injury.Description, injury.ID,
+	).Scan(&injury.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("injury not found")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update injury: %w", err)
+	}
+
+	if injury.Status == domain.InjuryRecovered {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE rehab_plans
+			SET status = $1, end_date = COALESCE(end_date, CURRENT_DATE), updated_at = CURRENT_TIMESTAMP
+			WHERE injury_id = $2 AND status = $3
+		`, domain.RehabCompleted, injury.ID, domain.RehabActive)
+		if err != nil {
+			return fmt.Errorf("failed to complete rehab plans: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *medicalRepository) ListPlayerInjuries(ctx context.Context, playerID string) ([]domain.Injury, error) {
+	query := `SELECT ` + injuryColumns + injuryJoins + `
+		WHERE i.player_id = $1
+		ORDER BY i.injury_date DESC, i.created_at DESC
+	`
+	return r.listInjuries(ctx, query, playerID)
+}
+
+func (r *medicalRepository) ListOpenInjuriesByTeam(ctx context.Context, teamID string) ([]domain.Injury, error) {
+	query := `SELECT ` + injuryColumns + injuryJoins + `
+		WHERE pl.team_id = $1 AND i.status <> 'recovered'
+		ORDER BY i.injury_date DESC, i.created_at DESC
+	`
+	return r.listInjuries(ctx, query, teamID)
+}
+
+func (r *medicalRepository) listInjuries(ctx context.Context, query string, args ...interface{}) ([]domain.Injury, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list injuries: %w", err)
+	}
+	defer rows.Close()
+
+	injuries := []domain.Injury{}
+	for rows.Next() {
+		var i domain.Injury
+		if err := scanInjury(rows, &i); err != nil {
+			return nil, fmt.Errorf("failed to scan injury: %w", err)
+		}
+		injuries = append(injuries, i)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return injuries, nil
+}
+
+func (r *medicalRepository) CreateRehabPlan(ctx context.Context, plan *domain.RehabPlan) error {
+	query := `
+		INSERT INTO rehab_plans (injury_id, physiotherapist_id, title, goals, start_date, end_date, status)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		plan.InjuryID, plan.PhysiotherapistID, plan.Title, plan.Goals, plan.StartDate, plan.EndDate, plan.Status,
+	).Scan(&plan.ID, &plan.CreatedAt, &plan.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create rehab plan: %w", err)
+	}
+
+	return nil
+}
+
+const rehabPlanColumns = `
+	id, injury_id, physiotherapist_id, title, goals, to_char(start_date, 'YYYY-MM-DD'),
+	to_char(end_date, 'YYYY-MM-DD'), status, created_at, updated_at`
+
+func scanRehabPlan(row scanner, p *domain.RehabPlan) error {
+	var goals, endDate sql.NullString
+
+	err := row.Scan(
+		&p.ID, &p.InjuryID, &p.PhysiotherapistID, &p.Title, &goals, &p.StartDate,
+		&endDate, &p.Status, &p.CreatedAt, &p.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	p.Goals = goals.String
+	if endDate.Valid {
+		p.EndDate = &endDate.String
+	}
+	p.Sessions = []domain.RehabSession{}
+
+	return nil
+}
+
+func (r *medicalRepository) GetRehabPlanByID(ctx context.Context, planID string) (*domain.RehabPlan, error) {
+	query := `SELECT ` + rehabPlanColumns + ` FROM rehab_plans WHERE id = $1`
+
+	var p domain.RehabPlan
+	err := scanRehabPlan(r.db.QueryRowContext(ctx, query, planID), &p)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("rehab plan not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rehab plan: %w", err)
+	}
+
+	return &p, nil
+}
+
+// ListRehabPlans returns the plans for an injury, each with its sessions
+func (r *medicalRepository) ListRehabPlans(ctx context.Context, injuryID string) ([]domain.RehabPlan, error) {
+	query := `SELECT ` + rehabPlanColumns + ` FROM rehab_plans WHERE injury_id = $1 ORDER BY start_date ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, injuryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rehab plans: %w", err)
+	}
+	defer rows.Close()
+
+	var plans []domain.RehabPlan
+	index := make(map[string]int)
+	for rows.Next() {
+		var p domain.RehabPlan
+		if err := scanRehabPlan(rows, &p); err != nil {
+			return nil, fmt.Errorf("failed to scan rehab plan: %w", err)
+		}
+		index[p.ID] = len(plans)
+		plans = append(plans, p)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	if len(plans) == 0 {
+		return plans, nil
+	}
+
+	planIDs := make([]string, 0, len(plans))
+	for _, p := range plans {
+		planIDs = append(planIDs, p.ID)
+	}
+
+	sessionRows, err := r.db.QueryContext(ctx, `
+		SELECT s.id, s.plan_id, s.appointment_id, to_char(a.appointment_date, 'YYYY-MM-DD'),
+		       to_char(a.appointment_time, 'HH24:MI'), a.status, s.notes, s.created_at
+		FROM rehab_sessions s
+		JOIN appointments a ON a.id = s.appointment_id
+		WHERE s.plan_id = ANY($1)
+		ORDER BY a.appointment_date ASC, a.appointment_time ASC
+	`, pq.Array(planIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rehab sessions: %w", err)
+	}
+	defer sessionRows.Close()
+
+	for sessionRows.Next() {
+		var s domain.RehabSession
+		var notes sql.NullString
+		if err := sessionRows.Scan(
+			&s.ID, &s.PlanID, &s.AppointmentID, &s.SessionDate,
+			&s.SessionTime, &s.AppointmentStatus, &notes, &s.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan rehab session: %w", err)
+		}
+		s.Notes = notes.String
+		plan := &plans[index[s.PlanID]]
+		plan.Sessions = append(plan.Sessions, s)
+	}
+
+	if err = sessionRows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return plans, nil
+}
+
+func (r *medicalRepository) AddRehabSession(ctx context.Context, session *domain.RehabSession) error {
+	query := `
+		INSERT INTO rehab_sessions (plan_id, appointment_id, notes)
+		VALUES ($1, $2, NULLIF($3, ''))
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, session.PlanID, session.AppointmentID, session.Notes).
+		Scan(&session.ID, &session.CreatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("appointment is already part of a rehab plan")
+		}
+		return fmt.Errorf("failed to add rehab session: %w", err)
+	}
+
+	return nil
+}
@@ -18,6 +18,74 @@ func NewMedicalRepository(db *sql.DB) domain.MedicalRepository {
 	return &medicalRepository{db: db}
 }
 
+// physioColumns selects a physiotherapist joined with its user, in the order read by scanPhysio
+const physioColumns = `
+	p.id, p.user_id, u.full_name, u.phone, p.specialization,
+	p.experience_years, p.qualifications, p.clinic_name, p.clinic_address,
+	p.consultation_fee, p.available_days, p.available_hours, p.slot_minutes, p.rating,
+	p.total_reviews, p.is_verified, p.bio, u.profile_picture_url,
+	p.created_at, p.updated_at`
+
+// appointmentColumns selects an appointment with its date and time formatted
+// the way they are sent by clients
+const appointmentColumns = `
+	a.id, a.physiotherapist_id, a.patient_id, to_char(a.appointment_date, 'YYYY-MM-DD'),
+	to_char(a.appointment_time, 'HH24:MI'), a.duration_minutes, a.status, a.complaint, a.notes,
+	a.fee, a.payment_status, a.rescheduled_from, a.created_at, a.updated_at`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanPhysio reads a row selected with physioColumns, followed by any extra columns
+func scanPhysio(row scanner, p *domain.Physiotherapist, extra ...interface{}) error {
+	var clinicName, bio sql.NullString
+	var profileImageURL sql.NullString
+
+	dest := []interface{}{
+		&p.ID, &p.UserID, &p.FullName, &p.Phone, &p.Specialization,
+		&p.ExperienceYears, pq.Array(&p.Qualifications), &clinicName, &p.ClinicAddress,
+		&p.ConsultationFee, pq.Array(&p.AvailableDays), &p.AvailableHours, &p.SlotMinutes, &p.Rating,
+		&p.TotalReviews, &p.IsVerified, &bio, &profileImageURL,
+		&p.CreatedAt, &p.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+
+	if clinicName.Valid {
+		p.ClinicName = clinicName.String
+	}
+	if bio.Valid {
+		p.Bio = bio.String
+	}
+	if profileImageURL.Valid {
+		p.ProfileImageURL = profileImageURL.String
+	}
+
+	return nil
+}
+
+// scanAppointment reads a row selected with appointmentColumns, followed by any extra columns
+func scanAppointment(row scanner, a *domain.Appointment, extra ...interface{}) error {
+	var complaint, notes, rescheduledFrom sql.NullString
+
+	dest := []interface{}{
+		&a.ID, &a.PhysiotherapistID, &a.PatientID, &a.AppointmentDate,
+		&a.AppointmentTime, &a.DurationMinutes, &a.Status, &complaint, &notes,
+		&a.Fee, &a.PaymentStatus, &rescheduledFrom, &a.CreatedAt, &a.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+
+	a.Complaint = complaint.String
+	a.Notes = notes.String
+	a.RescheduledFrom = rescheduledFrom.String
+
+	return nil
+}
+
 func (r *medicalRepository) ListPhysiotherapists(ctx context.Context, page, limit int) ([]domain.Physiotherapist, int, error) {
 	offset := (page - 1) * limit
 
@@ -31,12 +99,7 @@ func (r *medicalRepository) ListPhysiotherapists(ctx context.Context, page, limi
 
 	// Get paginated list with user details
 	query := `
-		SELECT 
-			p.id, p.user_id, u.full_name, u.phone, p.specialization,
-			p.experience_years, p.qualifications, p.clinic_name, p.clinic_address,
-			p.consultation_fee, p.available_days, p.available_hours, p.rating,
-			p.total_reviews, p.is_verified, p.bio, u.profile_picture_url,
-			p.created_at, p.updated_at
+		SELECT ` + physioColumns + `
 		FROM physiotherapists p
 		JOIN users u ON p.user_id = u.id
 		WHERE p.is_verified = true
@@ -53,30 +116,9 @@ func (r *medicalRepository) ListPhysiotherapists(ctx context.Context, page, limi
 	var physios []domain.Physiotherapist
 	for rows.Next() {
 		var p domain.Physiotherapist
-		var clinicName, bio sql.NullString
-		var profileImageURL sql.NullString
-
-		err := rows.Scan(
-			&p.ID, &p.UserID, &p.FullName, &p.Phone, &p.Specialization,
-			&p.ExperienceYears, pq.Array(&p.Qualifications), &clinicName, &p.ClinicAddress,
-			&p.ConsultationFee, pq.Array(&p.AvailableDays), &p.AvailableHours, &p.Rating,
-			&p.TotalReviews, &p.IsVerified, &bio, &profileImageURL,
-			&p.CreatedAt, &p.UpdatedAt,
-		)
-		if err != nil {
+		if err := scanPhysio(rows, &p); err != nil {
 			return nil, 0, fmt.Errorf("failed to scan physiotherapist: %w", err)
 		}
-
-		if clinicName.Valid {
-			p.ClinicName = clinicName.String
-		}
-		if bio.Valid {
-			p.Bio = bio.String
-		}
-		if profileImageURL.Valid {
-			p.ProfileImageURL = profileImageURL.String
-		}
-
 		physios = append(physios, p)
 	}
 
@@ -88,29 +130,26 @@ func (r *medicalRepository) ListPhysiotherapists(ctx context.Context, page, limi
 }
 
 func (r *medicalRepository) GetPhysiotherapistByID(ctx context.Context, physioID string) (*domain.Physiotherapist, error) {
+	return r.getPhysiotherapist(ctx, "p.id = $1", physioID)
+}
+
+// GetPhysiotherapistByUserID returns the user's physiotherapist profile once
+// it is verified. A profile still under review is only an application, so it
+// grants none of a physiotherapist's access.
+func (r *medicalRepository) GetPhysiotherapistByUserID(ctx context.Context, userID string) (*domain.Physiotherapist, error) {
+	return r.getPhysiotherapist(ctx, "p.user_id = $1 AND p.is_verified = true", userID)
+}
+
+func (r *medicalRepository) getPhysiotherapist(ctx context.Context, condition, value string) (*domain.Physiotherapist, error) {
 	query := `
-		SELECT 
-			p.id, p.user_id, u.full_name, u.phone, p.specialization,
-			p.experience_years, p.qualifications, p.clinic_name, p.clinic_address,
-			p.consultation_fee, p.available_days, p.available_hours, p.rating,
-			p.total_reviews, p.is_verified, p.bio, u.profile_picture_url,
-			p.created_at, p.updated_at
+		SELECT ` + physioColumns + `
 		FROM physiotherapists p
 		JOIN users u ON p.user_id = u.id
-		WHERE p.id = $1
+		WHERE ` + condition + `
 	`
 
 	var p domain.Physiotherapist
-	var clinicName, bio sql.NullString
-	var profileImageURL sql.NullString
-
-	err := r.db.QueryRowContext(ctx, query, physioID).Scan(
-		&p.ID, &p.UserID, &p.FullName, &p.Phone, &p.Specialization,
-		&p.ExperienceYears, pq.Array(&p.Qualifications), &clinicName, &p.ClinicAddress,
-		&p.ConsultationFee, pq.Array(&p.AvailableDays), &p.AvailableHours, &p.Rating,
-		&p.TotalReviews, &p.IsVerified, &bio, &profileImageURL,
-		&p.CreatedAt, &p.UpdatedAt,
-	)
+	err := scanPhysio(r.db.QueryRowContext(ctx, query, value), &p)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("physiotherapist not found")
@@ -119,29 +158,88 @@ func (r *medicalRepository) GetPhysiotherapistByID(ctx context.Context, physioID
 		return nil, fmt.Errorf("failed to get physiotherapist: %w", err)
 	}
 
-	if clinicName.Valid {
-		p.ClinicName = clinicName.String
+	return &p, nil
+}
+
+func (r *medicalRepository) CreateAppointment(ctx context.Context, appointment *domain.Appointment) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	if bio.Valid {
-		p.Bio = bio.String
+	defer tx.Rollback()
+
+	if err := insertAppointment(ctx, tx, appointment); err != nil {
+		return err
 	}
-	if profileImageURL.Valid {
-		p.ProfileImageURL = profileImageURL.String
+
+	if err := recordStatusChange(ctx, tx, appointment.ID, "", appointment.Status, appointment.PatientID, ""); err != nil {
+		return err
 	}
 
-	return &p, nil
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to create appointment: %w", err)
+	}
+
+	return nil
 }
 
-func (r *medicalRepository) CreateAppointment(ctx context.Context, appointment *domain.Appointment) error {
+// insertAppointment inserts an appointment after checking, under a lock on the
+// physiotherapist, that the day is not a leave day and no scheduled
+// appointment overlaps it
+func insertAppointment(ctx context.Context, tx *sql.Tx, appointment *domain.Appointment) error {
+	// Serialize appointments per physiotherapist so concurrent requests cannot both pass the overlap check
+	var physioID string
+	err := tx.QueryRowContext(ctx,
+		"SELECT id FROM physiotherapists WHERE id = $1 FOR UPDATE", appointment.PhysiotherapistID,
+	).Scan(&physioID)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("physiotherapist not found")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock physiotherapist: %w", err)
+	}
+
+	var onLeave bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM physio_leave_days
+			WHERE physiotherapist_id = $1 AND leave_date = $2
+		)
+	`, appointment.PhysiotherapistID, appointment.AppointmentDate).Scan(&onLeave)
+	if err != nil {
+		return fmt.Errorf("failed to check leave days: %w", err)
+	}
+	if onLeave {
+		return fmt.Errorf("physiotherapist is on leave on this date")
+	}
+
+	var overlapping bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM appointments
+			WHERE physiotherapist_id = $1 AND appointment_date = $2 AND status = 'scheduled'
+			  AND appointment_time < $3::time + make_interval(mins => $4)
+			  AND appointment_time + make_interval(mins => duration_minutes) > $3::time
+		)
+	`, appointment.PhysiotherapistID, appointment.AppointmentDate,
+		appointment.AppointmentTime, appointment.DurationMinutes,
+	).Scan(&overlapping)
+	if err != nil {
+		return fmt.Errorf("failed to check existing appointments: %w", err)
+	}
+	if overlapping {
+		return fmt.Errorf("appointment slot already booked")
+	}
+
 	query := `
 		INSERT INTO appointments (
 			id, physiotherapist_id, patient_id, appointment_date, appointment_time,
-			duration_minutes, status, complaint, notes, fee, payment_status
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			duration_minutes, status, complaint, notes, fee, payment_status, rescheduled_from
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		RETURNING created_at, updated_at
 	`
 
-	var complaint, notes sql.NullString
+	var complaint, notes, rescheduledFrom sql.NullString
 	if appointment.Complaint != "" {
 		complaint.String = appointment.Complaint
 		complaint.Valid = true
@@ -150,13 +248,17 @@ func (r *medicalRepository) CreateAppointment(ctx context.Context, appointment *
 		notes.String = appointment.Notes
 		notes.Valid = true
 	}
+	if appointment.RescheduledFrom != "" {
+		rescheduledFrom.String = appointment.RescheduledFrom
+		rescheduledFrom.Valid = true
+	}
 
-	err := r.db.QueryRowContext(
+	err = tx.QueryRowContext(
 		ctx, query,
 		appointment.ID, appointment.PhysiotherapistID, appointment.PatientID,
 		appointment.AppointmentDate, appointment.AppointmentTime,
 		appointment.DurationMinutes, appointment.Status, complaint, notes,
-		appointment.Fee, appointment.PaymentStatus,
+		appointment.Fee, appointment.PaymentStatus, rescheduledFrom,
 	).Scan(&appointment.CreatedAt, &appointment.UpdatedAt)
 
 	if err != nil {
@@ -174,13 +276,10 @@ func (r *medicalRepository) CreateAppointment(ctx context.Context, appointment *
 
 func (r *medicalRepository) GetAppointmentsByPatient(ctx context.Context, patientID string) ([]domain.Appointment, error) {
 	query := `
-		SELECT 
-			id, physiotherapist_id, patient_id, appointment_date, appointment_time,
-			duration_minutes, status, complaint, notes, fee, payment_status,
-			created_at, updated_at
-		FROM appointments
-		WHERE patient_id = $1
-		ORDER BY appointment_date DESC, appointment_time DESC
+		SELECT ` + appointmentColumns + `
+		FROM appointments a
+		WHERE a.patient_id = $1
+		ORDER BY a.appointment_date DESC, a.appointment_time DESC
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, patientID)
@@ -192,24 +291,9 @@ func (r *medicalRepository) GetAppointmentsByPatient(ctx context.Context, patien
 	var appointments []domain.Appointment
 	for rows.Next() {
 		var a domain.Appointment
-		var complaint, notes sql.NullString
-
-		err := rows.Scan(
-			&a.ID, &a.PhysiotherapistID, &a.PatientID, &a.AppointmentDate, &a.AppointmentTime,
-			&a.DurationMinutes, &a.Status, &complaint, &notes, &a.Fee, &a.PaymentStatus,
-			&a.CreatedAt, &a.UpdatedAt,
-		)
-		if err != nil {
+		if err := scanAppointment(rows, &a); err != nil {
 			return nil, fmt.Errorf("failed to scan appointment: %w", err)
 		}
-
-		if complaint.Valid {
-			a.Complaint = complaint.String
-		}
-		if notes.Valid {
-			a.Notes = notes.String
-		}
-
 		appointments = append(appointments, a)
 	}
 
@@ -222,22 +306,13 @@ func (r *medicalRepository) GetAppointmentsByPatient(ctx context.Context, patien
 
 func (r *medicalRepository) GetAppointmentByID(ctx context.Context, appointmentID string) (*domain.Appointment, error) {
 	query := `
-		SELECT 
-			id, physiotherapist_id, patient_id, appointment_date, appointment_time,
-			duration_minutes, status, complaint, notes, fee, payment_status,
-			created_at, updated_at
-		FROM appointments
-		WHERE id = $1
+		SELECT ` + appointmentColumns + `
+		FROM appointments a
+		WHERE a.id = $1
 	`
 
 	var a domain.Appointment
-	var complaint, notes sql.NullString
-
-	err := r.db.QueryRowContext(ctx, query, appointmentID).Scan(
-		&a.ID, &a.PhysiotherapistID, &a.PatientID, &a.AppointmentDate, &a.AppointmentTime,
-		&a.DurationMinutes, &a.Status, &complaint, &notes, &a.Fee, &a.PaymentStatus,
-		&a.CreatedAt, &a.UpdatedAt,
-	)
+	err := scanAppointment(r.db.QueryRowContext(ctx, query, appointmentID), &a)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("appointment not found")
@@ -246,12 +321,5 @@ func (r *medicalRepository) GetAppointmentByID(ctx context.Context, appointmentI
 		return nil, fmt.Errorf("failed to get appointment: %w", err)
 	}
 
-	if complaint.Valid {
-		a.Complaint = complaint.String
-	}
-	if notes.Valid {
-		a.Notes = notes.String
-	}
-
 	return &a, nil
 }
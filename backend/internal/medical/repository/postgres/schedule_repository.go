@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cricketapp/backend/internal/medical/domain"
+	"github.com/lib/pq"
+)
+
+func (r *medicalRepository) ListActiveAppointments(ctx context.Context, physioID, date string) ([]domain.Appointment, error) {
+	query := `
+		SELECT ` + appointmentColumns + `
+		FROM appointments a
+		WHERE a.physiotherapist_id = $1 AND a.appointment_date = $2 AND a.status = 'scheduled'
+		ORDER BY a.appointment_time ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, physioID, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appointments: %w", err)
+	}
+	defer rows.Close()
+
+	var appointments []domain.Appointment
+	for rows.Next() {
+		var a domain.Appointment
+		if err := scanAppointment(rows, &a); err != nil {
+			return nil, fmt.Errorf("failed to scan appointment: %w", err)
+		}
+		appointments = append(appointments, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return appointments, nil
+}
+
+func (r *medicalRepository) GetWorkingHours(ctx context.Context, physioID string) ([]domain.WorkingHours, error) {
+	query := `
+		SELECT day_of_week, to_char(start_time, 'HH24:MI'), to_char(end_time, 'HH24:MI'),
+		       to_char(break_start, 'HH24:MI'), to_char(break_end, 'HH24:MI')
+		FROM physio_working_hours
+		WHERE physiotherapist_id = $1
+		ORDER BY day_of_week ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, physioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get working hours: %w", err)
+	}
+	defer rows.Close()
+
+	hours := []domain.WorkingHours{}
+	for rows.Next() {
+		var h domain.WorkingHours
+		if err := rows.Scan(&h.DayOfWeek, &h.StartTime, &h.EndTime, &h.BreakStart, &h.BreakEnd); err != nil {
+			return nil, fmt.Errorf("failed to scan working hours: %w", err)
+		}
+		h.Day = time.Weekday(h.DayOfWeek).String()
+		hours = append(hours, h)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return hours, nil
+}
+
+// ReplaceSchedule swaps the weekly hours for new ones and keeps the free text
+// available_days and available_hours fields in step with them
+func (r *medicalRepository) ReplaceSchedule(ctx context.Context, physioID string, slotMinutes int, hours []domain.WorkingHours) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM physio_working_hours WHERE physiotherapist_id = $1", physioID); err != nil {
+		return fmt.Errorf("failed to clear working hours: %w", err)
+	}
+
+	days := make([]string, 0, len(hours))
+	earliest, latest := "", ""
+	for _, h := range hours {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO physio_working_hours (physiotherapist_id, day_of_week, start_time, end_time, break_start, break_end)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, physioID, h.DayOfWeek, h.StartTime, h.EndTime, h.BreakStart, h.BreakEnd)
+		if err != nil {
+			return fmt.Errorf("failed to save working hours: %w", err)
+		}
+
+		days = append(days, time.Weekday(h.DayOfWeek).String())
+		if earliest == "" || h.StartTime < earliest {
+			earliest = h.StartTime
+		}
+		if h.EndTime > latest {
+			latest = h.EndTime
+		}
+	}
+
+	availableHours := ""
+	if len(hours) > 0 {
+		availableHours = earliest + "-" + latest
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE physiotherapists
+		SET slot_minutes = $1, available_days = $2, available_hours = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4
+	`, slotMinutes, pq.Array(days), availableHours, physioID)
+	if err != nil {
+		return fmt.Errorf("failed to update physiotherapist: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *medicalRepository) ListLeaveDays(ctx context.Context, physioID, from, to string) ([]domain.LeaveDay, error) {
+	query := `
+		SELECT id, physiotherapist_id, to_char(leave_date, 'YYYY-MM-DD'), reason, created_at
+		FROM physio_leave_days
+		WHERE physiotherapist_id = $1 AND leave_date BETWEEN $2 AND $3
+		ORDER BY leave_date ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, physioID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leave days: %w", err)
+	}
+	defer rows.Close()
+
+	leaveDays := []domain.LeaveDay{}
+	for rows.Next() {
+		var l domain.LeaveDay
+		var reason sql.NullString
+		if err := rows.Scan(&l.ID, &l.PhysiotherapistID, &l.Date, &reason, &l.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan leave day: %w", err)
+		}
+		l.Reason = reason.String
+		leaveDays = append(leaveDays, l)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return leaveDays, nil
+}
+
+func (r *medicalRepository) AddLeaveDay(ctx context.Context, leave *domain.LeaveDay) error {
+	query := `
+		INSERT INTO physio_leave_days (physiotherapist_id, leave_date, reason)
+		VALUES ($1, $2, NULLIF($3, ''))
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, leave.PhysiotherapistID, leave.Date, strings.TrimSpace(leave.Reason)).
+		Scan(&leave.ID, &leave.CreatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("leave already added for this date")
+		}
+		return fmt.Errorf("failed to add leave day: %w", err)
+	}
+
+	return nil
+}
+
+func (r *medicalRepository) DeleteLeaveDay(ctx context.Context, physioID, leaveID string) error {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM physio_leave_days WHERE id = $1 AND physiotherapist_id = $2",
+		leaveID, physioID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete leave day: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete leave day: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("leave day not found")
+	}
+
+	return nil
+}
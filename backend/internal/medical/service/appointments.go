@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cricketapp/backend/internal/medical/domain"
+	"github.com/google/uuid"
+)
+
+func (s *medicalService) GetPhysioAppointments(ctx context.Context, userID, status string) ([]domain.Appointment, error) {
+	physio, err := s.repo.GetPhysiotherapistByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: only physiotherapists have an appointment dashboard")
+	}
+
+	switch status {
+	case "", domain.AppointmentScheduled, domain.AppointmentCompleted, domain.AppointmentCancelled,
+		domain.AppointmentRescheduled, domain.AppointmentNoShow:
+	default:
+		return nil, fmt.Errorf("invalid status filter")
+	}
+
+	// Only upcoming appointments unless a status is asked for, which may include past ones
+	from := time.Now().Format(dateLayout)
+	if status != "" && status != domain.AppointmentScheduled {
+		from = "0001-01-01"
+	}
+
+	return s.repo.ListPhysioAppointments(ctx, physio.ID, status, from)
+}
+
+func (s *medicalService) CompleteAppointment(ctx context.Context, userID, appointmentID string, req *domain.CompleteAppointmentRequest) (*domain.Appointment, error) {
+	notes := strings.TrimSpace(req.Notes)
+	if notes == "" {
+		return nil, fmt.Errorf("clinical notes are required")
+	}
+	if len(notes) > 5000 {
+		return nil, fmt.Errorf("clinical notes must not exceed 5000 characters")
+	}
+
+	appointment, err := s.physioAppointment(ctx, userID, appointmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireStarted(appointment); err != nil {
+		return nil, err
+	}
+
+	appointment.Status = domain.AppointmentCompleted
+	appointment.Notes = notes
+	if err := s.repo.UpdateAppointmentStatus(ctx, appointment, domain.AppointmentScheduled, userID, ""); err != nil {
+		return nil, err
+	}
+
+	return appointment, nil
+}
+
+func (s *medicalService) MarkNoShow(ctx context.Context, userID, appointmentID string, req *domain.CancelAppointmentRequest) (*domain.Appointment, error) {
+	appointment, err := s.physioAppointment(ctx, userID, appointmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireStarted(appointment); err != nil {
+		return nil, err
+	}
+
+	appointment.Status = domain.AppointmentNoShow
+	if err := s.repo.UpdateAppointmentStatus(ctx, appointment, domain.AppointmentScheduled, userID, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return appointment, nil
+}
+
+func (s *medicalService) CancelAppointment(ctx context.Context, userID, appointmentID string, req *domain.CancelAppointmentRequest) (*domain.Appointment, error) {
+	appointment, err := s.repo.GetAppointmentByID(ctx, appointmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	isPatient := appointment.PatientID == userID
+	if !isPatient {
+		physio, err := s.repo.GetPhysiotherapistByUserID(ctx, userID)
+		if err != nil || physio.ID != appointment.PhysiotherapistID {
+			return nil, fmt.Errorf("unauthorized: only the patient or physiotherapist can cancel this appointment")
+		}
+	}
+
+	if appointment.Status != domain.AppointmentScheduled {
+		return nil, fmt.Errorf("cannot cancel a %s appointment", appointment.Status)
+	}
+
+	start, err := appointmentStart(appointment)
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(start) {
+		return nil, fmt.Errorf("appointment has already started")
+	}
+	// Physiotherapists may cancel at any time before the start, patients need to give notice
+	if isPatient && time.Until(start) < s.noticePeriod {
+		return nil, fmt.Errorf("appointments must be cancelled at least %s before they start", s.noticePeriod)
+	}
+
+	appointment.Status = domain.AppointmentCancelled
+	if appointment.PaymentStatus == "paid" {
+		appointment.PaymentStatus = "refunded"
+	}
+	if err := s.repo.UpdateAppointmentStatus(ctx, appointment, domain.AppointmentScheduled, userID, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return appointment, nil
+}
+
+func (s *medicalService) RescheduleAppointment(ctx context.Context, userID, appointmentID string, req *domain.RescheduleAppointmentRequest) (*domain.Appointment, error) {
+	if req.AppointmentDate == "" {
+		return nil, fmt.Errorf("appointment date is required")
+	}
+	if req.AppointmentTime == "" {
+		return nil, fmt.Errorf("appointment time is required")
+	}
+
+	appointment, err := s.repo.GetAppointmentByID(ctx, appointmentID)
+	if err != nil {
+		return nil, err
+	}
+	if appointment.PatientID != userID {
+		return nil, fmt.Errorf("unauthorized: only the patient can reschedule this appointment")
+	}
+	if appointment.Status != domain.AppointmentScheduled {
+		return nil, fmt.Errorf("cannot reschedule a %s appointment", appointment.Status)
+	}
+
+	start, err := appointmentStart(appointment)
+	if err != nil {
+		return nil, err
+	}
+	if time.Until(start) < s.noticePeriod {
+		return nil, fmt.Errorf("appointments must be rescheduled at least %s before they start", s.noticePeriod)
+	}
+
+	physio, err := s.repo.GetPhysiotherapistByID(ctx, appointment.PhysiotherapistID)
+	if err != nil {
+		return nil, err
+	}
+
+	date, err := time.ParseInLocation(dateLayout, req.AppointmentDate, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid appointment date format, use YYYY-MM-DD")
+	}
+	if err := s.validateAppointmentSlot(ctx, physio, date, req.AppointmentTime); err != nil {
+		return nil, err
+	}
+
+	// The replacement keeps the fee and payment of the original appointment
+	replacement := &domain.Appointment{
+		ID:                uuid.New().String(),
+		PhysiotherapistID: appointment.PhysiotherapistID,
+		PatientID:         appointment.PatientID,
+		AppointmentDate:   req.AppointmentDate,
+		AppointmentTime:   req.AppointmentTime,
+		DurationMinutes:   physio.SlotMinutes,
+		Status:            domain.AppointmentScheduled,
+		Complaint:         appointment.Complaint,
+		Fee:               appointment.Fee,
+		PaymentStatus:     appointment.PaymentStatus,
+		RescheduledFrom:   appointment.ID,
+	}
+
+	if err := s.repo.RescheduleAppointment(ctx, appointment, replacement, userID, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return replacement, nil
+}
+
+func (s *medicalService) GetAppointmentHistory(ctx context.Context, userID, appointmentID string) ([]domain.StatusChange, error) {
+	appointment, err := s.repo.GetAppointmentByID(ctx, appointmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if appointment.PatientID != userID {
+		physio, err := s.repo.GetPhysiotherapistByUserID(ctx, userID)
+		if err != nil || physio.ID != appointment.PhysiotherapistID {
+			return nil, fmt.Errorf("unauthorized: you can only view your own appointments")
+		}
+	}
+
+	return s.repo.GetStatusHistory(ctx, appointmentID)
+}
+
+// physioAppointment loads a scheduled appointment and checks that userID is
+// the physiotherapist it is booked with
+func (s *medicalService) physioAppointment(ctx context.Context, userID, appointmentID string) (*domain.Appointment, error) {
+	appointment, err := s.repo.GetAppointmentByID(ctx, appointmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	physio, err := s.repo.GetPhysiotherapistByUserID(ctx, userID)
+	if err != nil || physio.ID != appointment.PhysiotherapistID {
+		return nil, fmt.Errorf("unauthorized: only the physiotherapist can update this appointment")
+	}
+
+	if appointment.Status != domain.AppointmentScheduled {
+		return nil, fmt.Errorf("appointment is already %s", appointment.Status)
+	}
+
+	return appointment, nil
+}
+
+// requireStarted rejects outcomes recorded for an appointment that has not started yet
+func requireStarted(appointment *domain.Appointment) error {
+	start, err := appointmentStart(appointment)
+	if err != nil {
+		return err
+	}
+	if time.Now().Before(start) {
+		return fmt.Errorf("appointment has not started yet")
+	}
+	return nil
+}
+
+func appointmentStart(appointment *domain.Appointment) (time.Time, error) {
+	start, err := time.ParseInLocation(dateLayout+" "+timeLayout,
+		appointment.AppointmentDate+" "+appointment.AppointmentTime, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid appointment start: %w", err)
+	}
+	return start, nil
+}
@@ -0,0 +1,424 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cricketapp/backend/internal/medical/domain"
+)
+
+func (s *medicalService) ReportInjury(ctx context.Context, userID, playerID string, req *domain.ReportInjuryRequest) (*domain.Injury, error) {
+	player, err := s.repo.GetTeamPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.canAccessPlayer(ctx, userID, player); err != nil {
+		return nil, err
+	}
+
+	bodyPart := strings.ToLower(strings.TrimSpace(req.BodyPart))
+	if bodyPart == "" {
+		return nil, fmt.Errorf("body part is required")
+	}
+	if len(bodyPart) > 50 {
+		return nil, fmt.Errorf("body part must not exceed 50 characters")
+	}
+	if !domain.ValidSeverities[req.Severity] {
+		return nil, fmt.Errorf("invalid severity. Must be: minor, moderate, or severe")
+	}
+
+	injuryDate, err := time.ParseInLocation(dateLayout, req.InjuryDate, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid injury date format, use YYYY-MM-DD")
+	}
+	if injuryDate.After(time.Now()) {
+		return nil, fmt.Errorf("injury date cannot be in the future")
+	}
+	if err := validateReturnDate(req.ExpectedReturnDate, req.InjuryDate); err != nil {
+		return nil, err
+	}
+
+	injury := &domain.Injury{
+		PlayerID:           playerID,
+		PlayerName:         player.FullName,
+		BodyPart:           bodyPart,
+		Severity:           req.Severity,
+		InjuryDate:         req.InjuryDate,
+		ExpectedReturnDate: req.ExpectedReturnDate,
+		Status:             domain.InjuryActive,
+		Description:        req.Description,
+		ReportedBy:         userID,
+	}
+	if err := s.repo.CreateInjury(ctx, injury); err != nil {
+		return nil, err
+	}
+
+	return injury, nil
+}
+
+func (s *medicalService) GetPlayerInjuries(ctx context.Context, userID, playerID string) ([]domain.Injury, error) {
+	player, err := s.repo.GetTeamPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.canAccessPlayer(ctx, userID, player); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListPlayerInjuries(ctx, playerID)
+}
+
+func (s *medicalService) GetInjury(ctx context.Context, userID, injuryID string) (*domain.Injury, error) {
+	injury, err := s.accessibleInjury(ctx, userID, injuryID)
+	if err != nil {
+		return nil, err
+	}
+
+	plans, err := s.repo.ListRehabPlans(ctx, injuryID)
+	if err != nil {
+		return nil, err
+	}
+	injury.RehabPlans = plans
+
+	return injury, nil
+}
+
+func (s *medicalService) UpdateInjury(ctx context.Context, userID, injuryID string, req *domain.UpdateInjuryRequest) (*domain.Injury, error) {
+	injury, err := s.accessibleInjury(ctx, userID, injuryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Severity != nil {
+		if !domain.ValidSeverities[*req.Severity] {
+			return nil, fmt.Errorf("invalid severity. Must be: minor, moderate, or severe")
+		}
+		injury.Severity = *req.Severity
+	}
+	if req.ExpectedReturnDate != nil {
+		if *req.ExpectedReturnDate == "" {
+			injury.ExpectedReturnDate = nil
+		} else {
+			if err := validateReturnDate(req.ExpectedReturnDate, injury.InjuryDate); err != nil {
+				return nil, err
+			}
+			injury.ExpectedReturnDate = req.ExpectedReturnDate
+		}
+	}
+	if req.Status != nil {
+		switch *req.Status {
+		case domain.InjuryActive, domain.InjuryRecovering, domain.InjuryRecovered:
+			injury.Status = *req.Status
+		default:
+			return nil, fmt.Errorf("invalid status. Must be: active, recovering, or recovered")
+		}
+	}
+	if req.Description != nil {
+		injury.Description = *req.Description
+	}
+
+	if err := s.repo.UpdateInjury(ctx, injury); err != nil {
+		return nil, err
+	}
+
+	return injury, nil
+}
+
+func (s *medicalService) CreateRehabPlan(ctx context.Context, userID, injuryID string, req *domain.CreateRehabPlanRequest) (*domain.RehabPlan, error) {
+	physio, err := s.repo.GetPhysiotherapistByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: only physiotherapists can create rehab plans")
+	}
+
+	injury, err := s.repo.GetInjuryByID(ctx, injuryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only a physiotherapist the player has seen or booked can plan their rehab
+	treating, err := s.repo.IsTreatingPlayer(ctx, physio.ID, injury.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+	if !treating {
+		return nil, fmt.Errorf("unauthorized: only a physiotherapist treating the player can create rehab plans")
+	}
+	if injury.Status == domain.InjuryRecovered {
+		return nil, fmt.Errorf("player has already recovered from this injury")
+	}
+
+	if strings.TrimSpace(req.Title) == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+	if _, err := time.ParseInLocation(dateLayout, req.StartDate, time.Local); err != nil {
+		return nil, fmt.Errorf("invalid start date format, use YYYY-MM-DD")
+	}
+	if req.EndDate != nil {
+		if _, err := time.ParseInLocation(dateLayout, *req.EndDate, time.Local); err != nil {
+			return nil, fmt.Errorf("invalid end date format, use YYYY-MM-DD")
+		}
+		if *req.EndDate < req.StartDate {
+			return nil, fmt.Errorf("end date must not be before start date")
+		}
+	}
+
+	plan := &domain.RehabPlan{
+		InjuryID:          injuryID,
+		PhysiotherapistID: physio.ID,
+		Title:             strings.TrimSpace(req.Title),
+		Goals:             req.Goals,
+		StartDate:         req.StartDate,
+		EndDate:           req.EndDate,
+		Status:            domain.RehabActive,
+		Sessions:          []domain.RehabSession{},
+	}
+	if err := s.repo.CreateRehabPlan(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (s *medicalService) AddRehabSession(ctx context.Context, userID, planID string, req *domain.AddRehabSessionRequest) (*domain.RehabSession, error) {
+	if req.AppointmentID == "" {
+		return nil, fmt.Errorf("appointment ID is required")
+	}
+
+	plan, err := s.repo.GetRehabPlanByID(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	physio, err := s.repo.GetPhysiotherapistByUserID(ctx, userID)
+	if err != nil || physio.ID != plan.PhysiotherapistID {
+		return nil, fmt.Errorf("unauthorized: only the plan's physiotherapist can add sessions")
+	}
+	if plan.Status != domain.RehabActive {
+		return nil, fmt.Errorf("rehab plan is already %s", plan.Status)
+	}
+
+	appointment, err := s.repo.GetAppointmentByID(ctx, req.AppointmentID)
+	if err != nil {
+		return nil, err
+	}
+	if appointment.PhysiotherapistID != plan.PhysiotherapistID {
+		return nil, fmt.Errorf("appointment is not with the plan's physiotherapist")
+	}
+	switch appointment.Status {
+	case domain.AppointmentScheduled, domain.AppointmentCompleted:
+	default:
+		return nil, fmt.Errorf("cannot add a %s appointment to a rehab plan", appointment.Status)
+	}
+
+	// The appointment must be the injured player's own
+	injury, err := s.repo.GetInjuryByID(ctx, plan.InjuryID)
+	if err != nil {
+		return nil, err
+	}
+	player, err := s.repo.GetTeamPlayer(ctx, injury.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+	if appointment.PatientID != player.UserID {
+		return nil, fmt.Errorf("appointment is not for the injured player")
+	}
+
+	notes := req.Notes
+	if notes == "" {
+		notes = appointment.Notes
+	}
+
+	session := &domain.RehabSession{
+		PlanID:            planID,
+		AppointmentID:     appointment.ID,
+		SessionDate:       appointment.AppointmentDate,
+		SessionTime:       appointment.AppointmentTime,
+		AppointmentStatus: appointment.Status,
+		Notes:             notes,
+	}
+	if err := s.repo.AddRehabSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *medicalService) GetTeamAvailability(ctx context.Context, userID, teamID, date string) (*domain.AvailabilityReport, error) {
+	staff, err := s.repo.GetTeamStaff(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if date == "" {
+		date = time.Now().Format(dateLayout)
+	}
+	if _, err := time.ParseInLocation(dateLayout, date, time.Local); err != nil {
+		return nil, fmt.Errorf("invalid date format, use YYYY-MM-DD")
+	}
+
+	players, err := s.repo.ListTeamPlayers(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Team managers, the team's own players and physiotherapists treating
+	// one of them may see the report
+	allowed := containsString(staff, userID)
+	for _, p := range players {
+		if p.UserID == userID {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		if physio, err := s.repo.GetPhysiotherapistByUserID(ctx, userID); err == nil {
+			if allowed, err = s.repo.IsTreatingTeam(ctx, physio.ID, teamID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !allowed {
+		return nil, fmt.Errorf("unauthorized: only team members and their physiotherapists can view team availability")
+	}
+
+	injuries, err := s.repo.ListOpenInjuriesByTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.AvailabilityReport{
+		TeamID:  teamID,
+		Date:    date,
+		Players: []domain.PlayerAvailability{},
+	}
+	for _, p := range players {
+		entry := domain.PlayerAvailability{TeamPlayer: p, Status: domain.PlayerAvailable}
+		for i := range injuries {
+			if injuries[i].PlayerID != p.ID {
+				continue
+			}
+			if status := availabilityOn(&injuries[i], date); status != domain.PlayerAvailable {
+				entry.Status = status
+				entry.Injury = &injuries[i]
+				break
+			}
+		}
+
+		switch entry.Status {
+		case domain.PlayerAvailable:
+			report.Available++
+		case domain.PlayerDoubtful:
+			report.Doubtful++
+		default:
+			report.Unavailable++
+		}
+		report.Players = append(report.Players, entry)
+	}
+
+	return report, nil
+}
+
+// FitnessWarning describes why a player may not be fit to play on date, or
+// returns an empty string when they have no open injury by then
+func (s *medicalService) FitnessWarning(ctx context.Context, playerID, date string) (string, error) {
+	injuries, err := s.repo.ListPlayerInjuries(ctx, playerID)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range injuries {
+		injury := &injuries[i]
+		if injury.Status == domain.InjuryRecovered {
+			continue
+		}
+
+		status := availabilityOn(injury, date)
+		if status == domain.PlayerAvailable {
+			continue
+		}
+
+		warning := fmt.Sprintf("%s is %s: %s %s injury", injury.PlayerName, status, injury.Severity, injury.BodyPart)
+		if injury.ExpectedReturnDate != nil {
+			warning += fmt.Sprintf(", expected back %s", *injury.ExpectedReturnDate)
+		}
+		return warning, nil
+	}
+
+	return "", nil
+}
+
+// availabilityOn works out how an open injury affects a player on date. A
+// player past their expected return who has not been cleared is doubtful.
+func availabilityOn(injury *domain.Injury, date string) string {
+	if injury.Status == domain.InjuryRecovered || injury.InjuryDate > date {
+		return domain.PlayerAvailable
+	}
+	if injury.ExpectedReturnDate != nil && *injury.ExpectedReturnDate <= date {
+		return domain.PlayerDoubtful
+	}
+	if injury.Status == domain.InjuryRecovering {
+		return domain.PlayerDoubtful
+	}
+	return domain.PlayerUnavailable
+}
+
+// accessibleInjury loads an injury the user may view and update
+func (s *medicalService) accessibleInjury(ctx context.Context, userID, injuryID string) (*domain.Injury, error) {
+	injury, err := s.repo.GetInjuryByID(ctx, injuryID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := s.repo.GetTeamPlayer(ctx, injury.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.canAccessPlayer(ctx, userID, player); err != nil {
+		return nil, err
+	}
+
+	return injury, nil
+}
+
+// canAccessPlayer allows the player themselves, their team's owner, managers
+// and captain, and verified physiotherapists treating the player to manage
+// the player's injuries
+func (s *medicalService) canAccessPlayer(ctx context.Context, userID string, player *domain.TeamPlayer) error {
+	if userID == player.UserID || containsString(player.TeamStaff, userID) {
+		return nil
+	}
+	if physio, err := s.repo.GetPhysiotherapistByUserID(ctx, userID); err == nil {
+		treating, err := s.repo.IsTreatingPlayer(ctx, physio.ID, player.ID)
+		if err != nil {
+			return err
+		}
+		if treating {
+			return nil
+		}
+	}
+	return fmt.Errorf("unauthorized: only the player, their team managers or their physiotherapist can manage injuries")
+}
+
+func validateReturnDate(returnDate *string, injuryDate string) error {
+	if returnDate == nil {
+		return nil
+	}
+	if _, err := time.ParseInLocation(dateLayout, *returnDate, time.Local); err != nil {
+		return fmt.Errorf("invalid expected return date format, use YYYY-MM-DD")
+	}
+	if *returnDate < injuryDate {
+		return fmt.Errorf("expected return date must not be before the injury date")
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
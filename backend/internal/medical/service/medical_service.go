@@ -12,12 +12,17 @@ import (
 )
 
 type medicalService struct {
-	repo domain.MedicalRepository
+	repo         domain.MedicalRepository
+	noticePeriod time.Duration
 }
 
-// NewMedicalService creates a new medical service
-func NewMedicalService(repo domain.MedicalRepository) domain.MedicalService {
-	return &medicalService{repo: repo}
+// NewMedicalService creates a medical service. Patients may cancel or
+// reschedule an appointment up to noticePeriod before it starts.
+func NewMedicalService(repo domain.MedicalRepository, noticePeriod time.Duration) domain.MedicalService {
+	return &medicalService{
+		repo:         repo,
+		noticePeriod: noticePeriod,
+	}
 }
 
 func (s *medicalService) ListPhysiotherapists(ctx context.Context, page, limit int) (*domain.PhysioListResponse, error) {
@@ -57,6 +62,11 @@ func (s *medicalService) GetPhysiotherapistDetails(ctx context.Context, physioID
 		return nil, fmt.Errorf("failed to get physiotherapist: %w", err)
 	}
 
+	// Profiles awaiting verification are only visible through the review queue
+	if !physio.IsVerified {
+		return nil, fmt.Errorf("physiotherapist not found")
+	}
+
 	return physio, nil
 }
 
@@ -68,37 +78,19 @@ func (s *medicalService) CreateAppointment(ctx context.Context, patientID string
 
 	// Check if physiotherapist exists
 	physio, err := s.repo.GetPhysiotherapistByID(ctx, req.PhysiotherapistID)
-	if err != nil {
+	if err != nil || !physio.IsVerified {
 		return nil, fmt.Errorf("physiotherapist not found")
 	}
 
 	// Parse appointment date
-	appointmentDate, err := time.Parse("2006-01-02", req.AppointmentDate)
+	appointmentDate, err := time.ParseInLocation("2006-01-02", req.AppointmentDate, time.Local)
 	if err != nil {
 		return nil, fmt.Errorf("invalid appointment date format, use YYYY-MM-DD")
 	}
 
-	// Check if date is in the future
-	if appointmentDate.Before(time.Now().Truncate(24 * time.Hour)) {
-		return nil, fmt.Errorf("appointment date must be in the future")
-	}
-
-	// Validate time format
-	if _, err := time.Parse("15:04", req.AppointmentTime); err != nil {
-		return nil, fmt.Errorf("invalid appointment time format, use HH:MM")
-	}
-
-	// Check if day is available
-	dayOfWeek := appointmentDate.Weekday().String()
-	dayAvailable := false
-	for _, availableDay := range physio.AvailableDays {
-		if strings.EqualFold(availableDay, dayOfWeek) {
-			dayAvailable = true
-			break
-		}
-	}
-	if !dayAvailable {
-		return nil, fmt.Errorf("physiotherapist is not available on %s", dayOfWeek)
+	// Check the time is a free slot in the physiotherapist's schedule
+	if err := s.validateAppointmentSlot(ctx, physio, appointmentDate, req.AppointmentTime); err != nil {
+		return nil, err
 	}
 
 	// Create appointment
@@ -108,8 +100,8 @@ func (s *medicalService) CreateAppointment(ctx context.Context, patientID string
 		PatientID:         patientID,
 		AppointmentDate:   req.AppointmentDate,
 		AppointmentTime:   req.AppointmentTime,
-		DurationMinutes:   60, // Default 1 hour
-		Status:            "scheduled",
+		DurationMinutes:   physio.SlotMinutes,
+		Status:            domain.AppointmentScheduled,
 		Complaint:         req.Complaint,
 		Fee:               physio.ConsultationFee,
 		PaymentStatus:     "pending",
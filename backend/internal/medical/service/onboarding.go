@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cricketapp/backend/internal/medical/domain"
+)
+
+const (
+	physioRole = "physio"
+	adminRole  = "admin"
+
+	// maxApplicationDocuments limits how many documents one application can attach
+	maxApplicationDocuments = 10
+)
+
+func (s *medicalService) ApplyAsPhysio(ctx context.Context, userID, role string, req *domain.PhysioApplicationRequest) (*domain.PhysioApplication, error) {
+	if role != physioRole {
+		return nil, fmt.Errorf("unauthorized: only users with the physio role can apply")
+	}
+
+	if err := validateApplication(req); err != nil {
+		return nil, err
+	}
+
+	application := &domain.PhysioApplication{
+		Physiotherapist: domain.Physiotherapist{
+			UserID:          userID,
+			Specialization:  strings.TrimSpace(req.Specialization),
+			ExperienceYears: req.ExperienceYears,
+			Qualifications:  req.Qualifications,
+			ClinicName:      strings.TrimSpace(req.ClinicName),
+			ClinicAddress:   strings.TrimSpace(req.ClinicAddress),
+			ConsultationFee: req.ConsultationFee,
+			Bio:             strings.TrimSpace(req.Bio),
+		},
+		Documents: req.Documents,
+	}
+
+	if err := s.repo.SavePhysioApplication(ctx, application); err != nil {
+		return nil, err
+	}
+
+	return application, nil
+}
+
+func (s *medicalService) GetMyApplication(ctx context.Context, userID string) (*domain.PhysioApplication, error) {
+	return s.repo.GetPhysioApplicationByUserID(ctx, userID)
+}
+
+func (s *medicalService) ListApplications(ctx context.Context, role, status string) ([]domain.PhysioApplication, error) {
+	if role != adminRole {
+		return nil, fmt.Errorf("unauthorized: only admins can review applications")
+	}
+
+	switch status {
+	case "":
+		status = domain.VerificationPending
+	case domain.VerificationPending, domain.VerificationApproved, domain.VerificationRejected:
+	default:
+		return nil, fmt.Errorf("invalid status. Must be: pending, approved, or rejected")
+	}
+
+	return s.repo.ListPhysioApplications(ctx, status)
+}
+
+func (s *medicalService) ApproveApplication(ctx context.Context, adminID, role, physioID string, req *domain.ReviewApplicationRequest) (*domain.PhysioApplication, error) {
+	return s.reviewApplication(ctx, adminID, role, physioID, domain.VerificationApproved, req.Reason)
+}
+
+func (s *medicalService) RejectApplication(ctx context.Context, adminID, role, physioID string, req *domain.ReviewApplicationRequest) (*domain.PhysioApplication, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, fmt.Errorf("a reason is required to reject an application")
+	}
+
+	return s.reviewApplication(ctx, adminID, role, physioID, domain.VerificationRejected, reason)
+}
+
+func (s *medicalService) reviewApplication(ctx context.Context, adminID, role, physioID, status, reason string) (*domain.PhysioApplication, error) {
+	if role != adminRole {
+		return nil, fmt.Errorf("unauthorized: only admins can review applications")
+	}
+
+	application, err := s.repo.GetPhysioApplication(ctx, physioID)
+	if err != nil {
+		return nil, err
+	}
+	if application.VerificationStatus != domain.VerificationPending {
+		return nil, fmt.Errorf("application is already %s", application.VerificationStatus)
+	}
+
+	application.VerificationStatus = status
+	application.RejectionReason = reason
+	application.ReviewedBy = adminID
+	if err := s.repo.ReviewPhysioApplication(ctx, application); err != nil {
+		return nil, err
+	}
+
+	return application, nil
+}
+
+func validateApplication(req *domain.PhysioApplicationRequest) error {
+	if strings.TrimSpace(req.Specialization) == "" {
+		return fmt.Errorf("specialization is required")
+	}
+	if req.ExperienceYears < 0 || req.ExperienceYears > 60 {
+		return fmt.Errorf("experience years must be between 0 and 60")
+	}
+	if len(req.Qualifications) == 0 {
+		return fmt.Errorf("at least one qualification is required")
+	}
+	for _, q := range req.Qualifications {
+		if strings.TrimSpace(q) == "" {
+			return fmt.Errorf("qualifications must not be empty")
+		}
+	}
+	if strings.TrimSpace(req.ClinicAddress) == "" {
+		return fmt.Errorf("clinic address is required")
+	}
+	if req.ConsultationFee <= 0 {
+		return fmt.Errorf("consultation fee must be greater than zero")
+	}
+	if len(req.Bio) > 2000 {
+		return fmt.Errorf("bio must not exceed 2000 characters")
+	}
+
+	if len(req.Documents) == 0 {
+		return fmt.Errorf("at least one supporting document is required")
+	}
+	if len(req.Documents) > maxApplicationDocuments {
+		return fmt.Errorf("an application can attach at most %d documents", maxApplicationDocuments)
+	}
+	for _, doc := range req.Documents {
+		u, err := url.Parse(doc)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid document URL: %s", doc)
+		}
+	}
+
+	return nil
+}
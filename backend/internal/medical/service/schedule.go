@@ -0,0 +1,307 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cricketapp/backend/internal/medical/domain"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	timeLayout = "15:04"
+
+	// leaveHorizonDays is how far ahead a schedule lists leave days
+	leaveHorizonDays = 90
+)
+
+func (s *medicalService) GetSchedule(ctx context.Context, physioID string) (*domain.Schedule, error) {
+	physio, err := s.repo.GetPhysiotherapistByID(ctx, physioID)
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err := s.repo.GetWorkingHours(ctx, physioID)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Now()
+	leaveDays, err := s.repo.ListLeaveDays(ctx, physioID,
+		today.Format(dateLayout), today.AddDate(0, 0, leaveHorizonDays).Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Schedule{
+		PhysiotherapistID: physio.ID,
+		SlotMinutes:       physio.SlotMinutes,
+		WorkingHours:      hours,
+		LeaveDays:         leaveDays,
+	}, nil
+}
+
+func (s *medicalService) UpdateSchedule(ctx context.Context, userID, physioID string, req *domain.UpdateScheduleRequest) (*domain.Schedule, error) {
+	if _, err := s.ownPhysio(ctx, userID, physioID); err != nil {
+		return nil, err
+	}
+
+	if req.SlotMinutes < 10 || req.SlotMinutes > 240 {
+		return nil, fmt.Errorf("slot minutes must be between 10 and 240")
+	}
+
+	seen := make(map[int]bool)
+	for _, h := range req.WorkingHours {
+		if h.DayOfWeek < 0 || h.DayOfWeek > 6 {
+			return nil, fmt.Errorf("day of week must be between 0 (Sunday) and 6 (Saturday)")
+		}
+		if seen[h.DayOfWeek] {
+			return nil, fmt.Errorf("%s is listed more than once", time.Weekday(h.DayOfWeek))
+		}
+		seen[h.DayOfWeek] = true
+
+		if err := validateWorkingHours(h, req.SlotMinutes); err != nil {
+			return nil, fmt.Errorf("%s: %w", time.Weekday(h.DayOfWeek), err)
+		}
+	}
+
+	if err := s.repo.ReplaceSchedule(ctx, physioID, req.SlotMinutes, req.WorkingHours); err != nil {
+		return nil, err
+	}
+
+	return s.GetSchedule(ctx, physioID)
+}
+
+func (s *medicalService) AddLeaveDay(ctx context.Context, userID, physioID string, req *domain.AddLeaveRequest) (*domain.LeaveDay, error) {
+	if _, err := s.ownPhysio(ctx, userID, physioID); err != nil {
+		return nil, err
+	}
+
+	date, err := time.ParseInLocation(dateLayout, req.Date, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date format, use YYYY-MM-DD")
+	}
+	now := time.Now()
+	if date.Before(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)) {
+		return nil, fmt.Errorf("leave date cannot be in the past")
+	}
+
+	// Patients must not silently lose their appointment
+	booked, err := s.repo.ListActiveAppointments(ctx, physioID, req.Date)
+	if err != nil {
+		return nil, err
+	}
+	if len(booked) > 0 {
+		return nil, fmt.Errorf("there are %d appointments on this date, cancel or reschedule them first", len(booked))
+	}
+
+	leave := &domain.LeaveDay{
+		PhysiotherapistID: physioID,
+		Date:              req.Date,
+		Reason:            req.Reason,
+	}
+	if err := s.repo.AddLeaveDay(ctx, leave); err != nil {
+		return nil, err
+	}
+
+	return leave, nil
+}
+
+func (s *medicalService) DeleteLeaveDay(ctx context.Context, userID, physioID, leaveID string) error {
+	if _, err := s.ownPhysio(ctx, userID, physioID); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteLeaveDay(ctx, physioID, leaveID)
+}
+
+func (s *medicalService) GetSlots(ctx context.Context, physioID, date string) (*domain.DaySlots, error) {
+	physio, err := s.repo.GetPhysiotherapistByID(ctx, physioID)
+	if err != nil {
+		return nil, err
+	}
+
+	if date == "" {
+		date = time.Now().Format(dateLayout)
+	}
+	day, err := time.ParseInLocation(dateLayout, date, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date format, use YYYY-MM-DD")
+	}
+
+	response := &domain.DaySlots{
+		PhysiotherapistID: physio.ID,
+		Date:              date,
+		SlotMinutes:       physio.SlotMinutes,
+		Slots:             []domain.Slot{},
+	}
+
+	hours, err := s.workingHoursOn(ctx, physioID, day)
+	if err != nil {
+		return nil, err
+	}
+	if hours == nil {
+		return response, nil
+	}
+	response.IsWorkingDay = true
+
+	leaveDays, err := s.repo.ListLeaveDays(ctx, physioID, date, date)
+	if err != nil {
+		return nil, err
+	}
+	if len(leaveDays) > 0 {
+		response.IsLeave = true
+		response.LeaveReason = leaveDays[0].Reason
+		return response, nil
+	}
+
+	booked, err := s.repo.ListActiveAppointments(ctx, physioID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, slot := range daySlots(*hours, physio.SlotMinutes) {
+		start, _ := time.ParseInLocation(dateLayout+" "+timeLayout, date+" "+slot.StartTime, time.Local)
+		if start.Before(now) {
+			slot.Status = "past"
+		} else {
+			for _, a := range booked {
+				bookedStart, _ := time.Parse(timeLayout, a.AppointmentTime)
+				bookedEnd := bookedStart.Add(time.Duration(a.DurationMinutes) * time.Minute).Format(timeLayout)
+				if a.AppointmentTime < slot.EndTime && bookedEnd > slot.StartTime {
+					slot.Status = "booked"
+					break
+				}
+			}
+		}
+		response.Slots = append(response.Slots, slot)
+	}
+
+	return response, nil
+}
+
+// validateAppointmentSlot checks that an appointment starts on one of the
+// physiotherapist's slots for that day and has not already started
+func (s *medicalService) validateAppointmentSlot(ctx context.Context, physio *domain.Physiotherapist, date time.Time, appointmentTime string) error {
+	hours, err := s.workingHoursOn(ctx, physio.ID, date)
+	if err != nil {
+		return err
+	}
+	if hours == nil {
+		return fmt.Errorf("physiotherapist is not available on %s", date.Weekday())
+	}
+
+	start, err := time.ParseInLocation(dateLayout+" "+timeLayout, date.Format(dateLayout)+" "+appointmentTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid appointment time format, use HH:MM")
+	}
+	if start.Before(time.Now()) {
+		return fmt.Errorf("appointment time must be in the future")
+	}
+
+	for _, slot := range daySlots(*hours, physio.SlotMinutes) {
+		if slot.StartTime == appointmentTime {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not an available slot, see the physiotherapist's slots for %s", appointmentTime, date.Format(dateLayout))
+}
+
+// workingHoursOn returns the hours worked on the weekday of date, or nil
+func (s *medicalService) workingHoursOn(ctx context.Context, physioID string, date time.Time) (*domain.WorkingHours, error) {
+	hours, err := s.repo.GetWorkingHours(ctx, physioID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range hours {
+		if h.DayOfWeek == int(date.Weekday()) {
+			return &h, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ownPhysio loads a physiotherapist and checks that userID is its account
+func (s *medicalService) ownPhysio(ctx context.Context, userID, physioID string) (*domain.Physiotherapist, error) {
+	physio, err := s.repo.GetPhysiotherapistByID(ctx, physioID)
+	if err != nil {
+		return nil, err
+	}
+
+	if physio.UserID != userID {
+		return nil, fmt.Errorf("unauthorized: only the physiotherapist can manage this schedule")
+	}
+
+	return physio, nil
+}
+
+// daySlots splits a working day into slots of slotMinutes, skipping any that
+// overlap the break
+func daySlots(hours domain.WorkingHours, slotMinutes int) []domain.Slot {
+	start, _ := time.Parse(timeLayout, hours.StartTime)
+	end, _ := time.Parse(timeLayout, hours.EndTime)
+	length := time.Duration(slotMinutes) * time.Minute
+
+	var breakStart, breakEnd time.Time
+	hasBreak := hours.BreakStart != nil && hours.BreakEnd != nil
+	if hasBreak {
+		breakStart, _ = time.Parse(timeLayout, *hours.BreakStart)
+		breakEnd, _ = time.Parse(timeLayout, *hours.BreakEnd)
+	}
+
+	var slots []domain.Slot
+	for t := start; length > 0 && !t.Add(length).After(end); t = t.Add(length) {
+		if hasBreak && t.Before(breakEnd) && t.Add(length).After(breakStart) {
+			continue
+		}
+		slots = append(slots, domain.Slot{
+			StartTime: t.Format(timeLayout),
+			EndTime:   t.Add(length).Format(timeLayout),
+			Status:    "free",
+		})
+	}
+
+	return slots
+}
+
+func validateWorkingHours(h domain.WorkingHours, slotMinutes int) error {
+	start, err := time.Parse(timeLayout, h.StartTime)
+	if err != nil {
+		return fmt.Errorf("invalid start time, use HH:MM")
+	}
+	end, err := time.Parse(timeLayout, h.EndTime)
+	if err != nil {
+		return fmt.Errorf("invalid end time, use HH:MM")
+	}
+	if !end.After(start) {
+		return fmt.Errorf("end time must be after start time")
+	}
+
+	if (h.BreakStart == nil) != (h.BreakEnd == nil) {
+		return fmt.Errorf("a break needs both a start and an end")
+	}
+	if h.BreakStart != nil {
+		breakStart, err := time.Parse(timeLayout, *h.BreakStart)
+		if err != nil {
+			return fmt.Errorf("invalid break start, use HH:MM")
+		}
+		breakEnd, err := time.Parse(timeLayout, *h.BreakEnd)
+		if err != nil {
+			return fmt.Errorf("invalid break end, use HH:MM")
+		}
+		if !breakEnd.After(breakStart) || breakStart.Before(start) || breakEnd.After(end) {
+			return fmt.Errorf("break must fall within working hours")
+		}
+	}
+
+	if len(daySlots(h, slotMinutes)) == 0 {
+		return fmt.Errorf("working hours must fit at least one slot")
+	}
+
+	return nil
+}
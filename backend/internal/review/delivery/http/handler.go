@@ -0,0 +1,128 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/cricketapp/backend/internal/review/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type ReviewHandler struct {
+	service domain.ReviewService
+}
+
+// NewReviewHandler creates a new review handler
+func NewReviewHandler(service domain.ReviewService) *ReviewHandler {
+	return &ReviewHandler{service: service}
+}
+
+func getUserID(r *http.Request) (uuid.UUID, error) {
+	userID := r.Context().Value("user_id").(string)
+	return uuid.Parse(userID)
+}
+
+// ReviewBooking handles POST /bookings/{id}/review
+func (h *ReviewHandler) ReviewBooking(w http.ResponseWriter, r *http.Request) {
+	h.createReview(w, r, h.service.ReviewBooking, "Invalid booking ID")
+}
+
+// ReviewAppointment handles POST /appointments/{id}/review
+func (h *ReviewHandler) ReviewAppointment(w http.ResponseWriter, r *http.Request) {
+	h.createReview(w, r, h.service.ReviewAppointment, "Invalid appointment ID")
+}
+
+type createReviewFunc func(ctx context.Context, id uuid.UUID, req domain.CreateReviewRequest, userID uuid.UUID) (*domain.Review, error)
+
+func (h *ReviewHandler) createReview(w http.ResponseWriter, r *http.Request, create createReviewFunc, invalidID string) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, invalidID, http.StatusBadRequest)
+		return
+	}
+
+	var req domain.CreateReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	review, err := create(r.Context(), id, req, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(review)
+}
+
+// ListGroundReviews handles GET /grounds/{id}/reviews
+func (h *ReviewHandler) ListGroundReviews(w http.ResponseWriter, r *http.Request) {
+	h.listReviews(w, r, domain.SubjectGround, "Invalid ground ID")
+}
+
+// ListPhysiotherapistReviews handles GET /physiotherapists/{id}/reviews
+func (h *ReviewHandler) ListPhysiotherapistReviews(w http.ResponseWriter, r *http.Request) {
+	h.listReviews(w, r, domain.SubjectPhysiotherapist, "Invalid physiotherapist ID")
+}
+
+func (h *ReviewHandler) listReviews(w http.ResponseWriter, r *http.Request, subjectType, invalidID string) {
+	subjectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, invalidID, http.StatusBadRequest)
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	response, err := h.service.ListReviews(r.Context(), subjectType, subjectID, page, limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ReplyToReview handles POST /reviews/{id}/reply
+func (h *ReviewHandler) ReplyToReview(w http.ResponseWriter, r *http.Request) {
+	reviewID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid review ID", http.StatusBadRequest)
+		return
+	}
+
+	var req domain.ReplyReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	review, err := h.service.ReplyToReview(r.Context(), reviewID, req, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(review)
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ReviewRepository interface {
+	GetBookingEngagement(ctx context.Context, bookingID uuid.UUID) (*Engagement, error)
+	GetAppointmentEngagement(ctx context.Context, appointmentID uuid.UUID) (*Engagement, error)
+
+	// CreateReview saves a review and refreshes the rating of its subject in one transaction
+	CreateReview(ctx context.Context, review *Review) error
+	GetReview(ctx context.Context, reviewID uuid.UUID) (*Review, error)
+	ListReviews(ctx context.Context, subjectType string, subjectID uuid.UUID, page, limit int) ([]Review, int, error)
+	ReplyToReview(ctx context.Context, reviewID uuid.UUID, reply string) error
+
+	// GetSubjectOwner returns the user who manages a ground or physiotherapist profile
+	GetSubjectOwner(ctx context.Context, subjectType string, subjectID uuid.UUID) (uuid.UUID, error)
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Things that can be reviewed
+const (
+	SubjectGround          = "ground"
+	SubjectPhysiotherapist = "physiotherapist"
+)
+
+// Review is a rating left by a user after a completed booking or appointment
+type Review struct {
+	ID            uuid.UUID  `json:"id"`
+	SubjectType   string     `json:"subject_type"` // ground, physiotherapist
+	SubjectID     uuid.UUID  `json:"subject_id"`
+	BookingID     *uuid.UUID `json:"booking_id,omitempty"`
+	AppointmentID *uuid.UUID `json:"appointment_id,omitempty"`
+	ReviewerID    uuid.UUID  `json:"reviewer_id"`
+	ReviewerName  string     `json:"reviewer_name,omitempty"`
+	Rating        int        `json:"rating"`
+	Comment       *string    `json:"comment,omitempty"`
+	Reply         *string    `json:"reply,omitempty"`
+	RepliedAt     *time.Time `json:"replied_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// Engagement is the booking or appointment a review is left for
+type Engagement struct {
+	SubjectType string
+	SubjectID   uuid.UUID
+	UserID      uuid.UUID
+	Status      string
+}
+
+type CreateReviewRequest struct {
+	Rating  int     `json:"rating" binding:"required,min=1,max=5"`
+	Comment *string `json:"comment,omitempty"`
+}
+
+type ReplyReviewRequest struct {
+	Reply string `json:"reply" binding:"required"`
+}
+
+type ReviewListResponse struct {
+	Reviews []Review `json:"reviews"`
+	Total   int      `json:"total"`
+	Page    int      `json:"page"`
+	Limit   int      `json:"limit"`
+}
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ReviewService interface {
+	ReviewBooking(ctx context.Context, bookingID uuid.UUID, req CreateReviewRequest, userID uuid.UUID) (*Review, error)
+	ReviewAppointment(ctx context.Context, appointmentID uuid.UUID, req CreateReviewRequest, userID uuid.UUID) (*Review, error)
+	ListReviews(ctx context.Context, subjectType string, subjectID uuid.UUID, page, limit int) (*ReviewListResponse, error)
+	ReplyToReview(ctx context.Context, reviewID uuid.UUID, req ReplyReviewRequest, userID uuid.UUID) (*Review, error)
+}
@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/review/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// subjectTables maps a review subject to the table holding its rating
+var subjectTables = map[string]string{
+	domain.SubjectGround:          "grounds",
+	domain.SubjectPhysiotherapist: "physiotherapists",
+}
+
+type reviewRepository struct {
+	db *sql.DB
+}
+
+func NewReviewRepository(db *sql.DB) domain.ReviewRepository {
+	return &reviewRepository{db: db}
+}
+
+func (r *reviewRepository) GetBookingEngagement(ctx context.Context, bookingID uuid.UUID) (*domain.Engagement, error) {
+	e := &domain.Engagement{SubjectType: domain.SubjectGround}
+	err := r.db.QueryRowContext(ctx,
+		"SELECT ground_id, user_id, status FROM bookings WHERE id = $1", bookingID,
+	).Scan(&e.SubjectID, &e.UserID, &e.Status)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("booking not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (r *reviewRepository) GetAppointmentEngagement(ctx context.Context, appointmentID uuid.UUID) (*domain.Engagement, error) {
+	e := &domain.Engagement{SubjectType: domain.SubjectPhysiotherapist}
+	err := r.db.QueryRowContext(ctx,
+		"SELECT physiotherapist_id, patient_id, status FROM appointments WHERE id = $1", appointmentID,
+	).Scan(&e.SubjectID, &e.UserID, &e.Status)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("appointment not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// CreateReview inserts the review and recalculates the subject's rating and
+// review count while holding a lock on the subject row, so concurrent reviews
+// cannot leave a stale aggregate behind
+func (r *reviewRepository) CreateReview(ctx context.Context, review *domain.Review) error {
+	table, ok := subjectTables[review.SubjectType]
+	if !ok {
+		return fmt.Errorf("invalid review subject: %s", review.SubjectType)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var locked uuid.UUID
+	err = tx.QueryRowContext(ctx,
+		fmt.Sprintf("SELECT id FROM %s WHERE id = $1 FOR UPDATE", table), review.SubjectID,
+	).Scan(&locked)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("%s not found", review.SubjectType)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO reviews (subject_type, subject_id, booking_id, appointment_id, reviewer_id, rating, comment)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at
+	`,
+		review.SubjectType, review.SubjectID, review.BookingID, review.AppointmentID,
+		review.ReviewerID, review.Rating, review.Comment,
+	).Scan(&review.ID, &review.CreatedAt, &review.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("you have already reviewed this %s", engagementName(review))
+		}
+		return fmt.Errorf("failed to create review: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET rating = agg.rating, total_reviews = agg.total, updated_at = CURRENT_TIMESTAMP
+		FROM (
+			SELECT COALESCE(ROUND(AVG(rating), 2), 0) AS rating, COUNT(*) AS total
+			FROM reviews
+			WHERE subject_type = $1 AND subject_id = $2
+		) agg
+		WHERE id = $2
+	`, table), review.SubjectType, review.SubjectID)
+	if err != nil {
+		return fmt.Errorf("failed to update rating: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *reviewRepository) GetReview(ctx context.Context, reviewID uuid.UUID) (*domain.Review, error) {
+	query := `
+		SELECT rv.id, rv.subject_type, rv.subject_id, rv.booking_id, rv.appointment_id,
+		       rv.reviewer_id, u.full_name, rv.rating, rv.comment, rv.reply, rv.replied_at,
+		       rv.created_at, rv.updated_at
+		FROM reviews rv
+		JOIN users u ON u.id = rv.reviewer_id
+		WHERE rv.id = $1
+	`
+
+	review, err := scanReview(r.db.QueryRowContext(ctx, query, reviewID))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("review not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (r *reviewRepository) ListReviews(ctx context.Context, subjectType string, subjectID uuid.UUID, page, limit int) ([]domain.Review, int, error) {
+	var total int
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM reviews WHERE subject_type = $1 AND subject_id = $2",
+		subjectType, subjectID,
+	).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT rv.id, rv.subject_type, rv.subject_id, rv.booking_id, rv.appointment_id,
+		       rv.reviewer_id, u.full_name, rv.rating, rv.comment, rv.reply, rv.replied_at,
+		       rv.created_at, rv.updated_at
+		FROM reviews rv
+		JOIN users u ON u.id = rv.reviewer_id
+		WHERE rv.subject_type = $1 AND rv.subject_id = $2
+		ORDER BY rv.created_at DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, subjectType, subjectID, limit, (page-1)*limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	reviews := []domain.Review{}
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		reviews = append(reviews, *review)
+	}
+
+	return reviews, total, rows.Err()
+}
+
+func (r *reviewRepository) ReplyToReview(ctx context.Context, reviewID uuid.UUID, reply string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE reviews
+		SET reply = $1, replied_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2
+	`, reply, reviewID)
+	if err != nil {
+		return fmt.Errorf("failed to save reply: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("review not found")
+	}
+
+	return nil
+}
+
+func (r *reviewRepository) GetSubjectOwner(ctx context.Context, subjectType string, subjectID uuid.UUID) (uuid.UUID, error) {
+	var query string
+	switch subjectType {
+	case domain.SubjectGround:
+		query = "SELECT owner_id FROM grounds WHERE id = $1"
+	case domain.SubjectPhysiotherapist:
+		query = "SELECT user_id FROM physiotherapists WHERE id = $1"
+	default:
+		return uuid.Nil, fmt.Errorf("invalid review subject: %s", subjectType)
+	}
+
+	var ownerID uuid.UUID
+	err := r.db.QueryRowContext(ctx, query, subjectID).Scan(&ownerID)
+	if err == sql.ErrNoRows {
+		return uuid.Nil, fmt.Errorf("%s not found", subjectType)
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return ownerID, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanReview(row scanner) (*domain.Review, error) {
+	review := &domain.Review{}
+	err := row.Scan(
+		&review.ID, &review.SubjectType, &review.SubjectID, &review.BookingID, &review.AppointmentID,
+		&review.ReviewerID, &review.ReviewerName, &review.Rating, &review.Comment, &review.Reply, &review.RepliedAt,
+		&review.CreatedAt, &review.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func engagementName(review *domain.Review) string {
+	if review.BookingID != nil {
+		return "booking"
+	}
+	return "appointment"
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cricketapp/backend/internal/review/domain"
+	"github.com/google/uuid"
+)
+
+// maxReviewLength limits review comments and owner replies
+const maxReviewLength = 2000
+
+type reviewService struct {
+	repo domain.ReviewRepository
+}
+
+func NewReviewService(repo domain.ReviewRepository) domain.ReviewService {
+	return &reviewService{repo: repo}
+}
+
+func (s *reviewService) ReviewBooking(ctx context.Context, bookingID uuid.UUID, req domain.CreateReviewRequest, userID uuid.UUID) (*domain.Review, error) {
+	engagement, err := s.repo.GetBookingEngagement(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.newReview(engagement, req, userID, "booking")
+	if err != nil {
+		return nil, err
+	}
+	review.BookingID = &bookingID
+
+	return s.create(ctx, review)
+}
+
+func (s *reviewService) ReviewAppointment(ctx context.Context, appointmentID uuid.UUID, req domain.CreateReviewRequest, userID uuid.UUID) (*domain.Review, error) {
+	engagement, err := s.repo.GetAppointmentEngagement(ctx, appointmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.newReview(engagement, req, userID, "appointment")
+	if err != nil {
+		return nil, err
+	}
+	review.AppointmentID = &appointmentID
+
+	return s.create(ctx, review)
+}
+
+func (s *reviewService) ListReviews(ctx context.Context, subjectType string, subjectID uuid.UUID, page, limit int) (*domain.ReviewListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+
+	reviews, total, err := s.repo.ListReviews(ctx, subjectType, subjectID, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ReviewListResponse{
+		Reviews: reviews,
+		Total:   total,
+		Page:    page,
+		Limit:   limit,
+	}, nil
+}
+
+func (s *reviewService) ReplyToReview(ctx context.Context, reviewID uuid.UUID, req domain.ReplyReviewRequest, userID uuid.UUID) (*domain.Review, error) {
+	reply := strings.TrimSpace(req.Reply)
+	if reply == "" {
+		return nil, fmt.Errorf("reply is required")
+	}
+	if len(reply) > maxReviewLength {
+		return nil, fmt.Errorf("reply must not exceed %d characters", maxReviewLength)
+	}
+
+	review, err := s.repo.GetReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := s.repo.GetSubjectOwner(ctx, review.SubjectType, review.SubjectID)
+	if err != nil {
+		return nil, err
+	}
+	if ownerID != userID {
+		return nil, fmt.Errorf("unauthorized: only the owner can reply to this review")
+	}
+
+	if err := s.repo.ReplyToReview(ctx, reviewID, reply); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetReview(ctx, reviewID)
+}
+
+// newReview checks that userID took part in a completed engagement and builds
+// the review for it
+func (s *reviewService) newReview(engagement *domain.Engagement, req domain.CreateReviewRequest, userID uuid.UUID, kind string) (*domain.Review, error) {
+	if engagement.UserID != userID {
+		return nil, fmt.Errorf("unauthorized: only the user who made the %s can review it", kind)
+	}
+	if engagement.Status != "completed" {
+		return nil, fmt.Errorf("only completed %ss can be reviewed", kind)
+	}
+
+	if req.Rating < 1 || req.Rating > 5 {
+		return nil, fmt.Errorf("rating must be between 1 and 5")
+	}
+
+	var comment *string
+	if req.Comment != nil {
+		if trimmed := strings.TrimSpace(*req.Comment); trimmed != "" {
+			if len(trimmed) > maxReviewLength {
+				return nil, fmt.Errorf("comment must not exceed %d characters", maxReviewLength)
+			}
+			comment = &trimmed
+		}
+	}
+
+	return &domain.Review{
+		SubjectType: engagement.SubjectType,
+		SubjectID:   engagement.SubjectID,
+		ReviewerID:  userID,
+		Rating:      req.Rating,
+		Comment:     comment,
+	}, nil
+}
+
+func (s *reviewService) create(ctx context.Context, review *domain.Review) (*domain.Review, error) {
+	if err := s.repo.CreateReview(ctx, review); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetReview(ctx, review.ID)
+}
@@ -20,6 +20,12 @@ func NewStatisticsHandler(service domain.StatisticsService) *StatisticsHandler {
 	return &StatisticsHandler{service: service}
 }
 
+// getUserID extracts and parses the user ID from request context
+func getUserID(r *http.Request) (uuid.UUID, error) {
+	userID := r.Context().Value("user_id").(string)
+	return uuid.Parse(userID)
+}
+
 // RecordPerformance handles POST /performances
 func (h *StatisticsHandler) RecordPerformance(w http.ResponseWriter, r *http.Request) {
 	var req domain.RecordPerformanceRequest
@@ -28,7 +34,13 @@ func (h *StatisticsHandler) RecordPerformance(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	perf, err := h.service.RecordPerformance(req)
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusUnauthorized)
+		return
+	}
+
+	perf, err := h.service.RecordPerformance(req, userID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
@@ -142,7 +154,13 @@ func (h *StatisticsHandler) UpdatePerformance(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	perf, err := h.service.UpdatePerformance(id, req)
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusUnauthorized)
+		return
+	}
+
+	perf, err := h.service.UpdatePerformance(id, req, userID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
@@ -161,7 +179,13 @@ func (h *StatisticsHandler) DeletePerformance(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	err = h.service.DeletePerformance(id)
+	userID, err := getUserID(r)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusUnauthorized)
+		return
+	}
+
+	err = h.service.DeletePerformance(id, userID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
@@ -170,6 +194,28 @@ func (h *StatisticsHandler) DeletePerformance(w http.ResponseWriter, r *http.Req
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// BuildMatchPerformances handles POST /matches/{id}/performances/rebuild
+func (h *StatisticsHandler) BuildMatchPerformances(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		http.Error(w, "Invalid match ID", http.StatusBadRequest)
+		return
+	}
+
+	performances, err := h.service.BuildMatchPerformances(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"performances": performances,
+		"total":        len(performances),
+	})
+}
+
 // GetPlayerStats handles GET /players/{id}/stats
 func (h *StatisticsHandler) GetPlayerStats(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
@@ -11,6 +11,9 @@ type StatisticsRepository interface {
 	ListPerformances(filters PerformanceFilters) ([]PlayerMatchPerformance, int, error)
 	UpdatePerformance(performanceID uuid.UUID, updates map[string]interface{}) error
 	DeletePerformance(performanceID uuid.UUID) error
+	RebuildMatchPerformances(matchID uuid.UUID) ([]uuid.UUID, error)
+	ListInningsPerformances(playerID, matchID uuid.UUID) ([]PlayerInningsPerformance, error)
+	GetMatchCreator(matchID uuid.UUID) (uuid.UUID, error)
 
 	// Career stats operations
 	GetCareerStats(playerID uuid.UUID) (*PlayerCareerStats, error)
@@ -2,15 +2,26 @@ package domain
 
 import "github.com/google/uuid"
 
+// MatchLimits are the parts of a match's rules that bound a player's
+// performance in it
+type MatchLimits struct {
+	BowlerQuota int  // most overs one bowler may bowl, 0 when there is no quota
+	RetiredOut  bool // whether a batter may retire out
+}
+
+// MatchLimitsLookup resolves the limits of a match from its rules profile
+type MatchLimitsLookup func(matchID uuid.UUID) (*MatchLimits, error)
+
 // StatisticsService defines business logic for player statistics
 type StatisticsService interface {
 	// Performance operations
-	RecordPerformance(req RecordPerformanceRequest) (*PlayerMatchPerformance, error)
+	RecordPerformance(req RecordPerformanceRequest, userID uuid.UUID) (*PlayerMatchPerformance, error)
 	GetPerformance(performanceID uuid.UUID) (*PlayerMatchPerformance, error)
 	GetPlayerMatchPerformance(playerID, matchID uuid.UUID) (*PlayerMatchPerformance, error)
 	ListPerformances(filters PerformanceFilters) ([]PlayerMatchPerformance, int, error)
-	UpdatePerformance(performanceID uuid.UUID, req UpdatePerformanceRequest) (*PlayerMatchPerformance, error)
-	DeletePerformance(performanceID uuid.UUID) error
+	UpdatePerformance(performanceID uuid.UUID, req UpdatePerformanceRequest, userID uuid.UUID) (*PlayerMatchPerformance, error)
+	DeletePerformance(performanceID, userID uuid.UUID) error
+	BuildMatchPerformances(matchID uuid.UUID) ([]PlayerMatchPerformance, error)
 
 	// Career stats operations
 	GetPlayerCareerStats(playerID uuid.UUID) (*PlayerCareerStats, error)
@@ -36,6 +36,34 @@ type PlayerMatchPerformance struct {
 	PlayerOfMatch       bool       `json:"player_of_match"`
 	CreatedAt           time.Time  `json:"created_at"`
 	UpdatedAt           time.Time  `json:"updated_at"`
+
+	// Innings splits the performance per innings for matches scored ball by ball
+	Innings []PlayerInningsPerformance `json:"innings,omitempty"`
+}
+
+// PlayerInningsPerformance represents a player's part in one innings of a match.
+// Super overs are listed here but left out of the match totals.
+type PlayerInningsPerformance struct {
+	ID                  uuid.UUID  `json:"id"`
+	PlayerID            uuid.UUID  `json:"player_id"`
+	MatchID             uuid.UUID  `json:"match_id"`
+	InningsNumber       int        `json:"innings_number"`
+	TeamID              uuid.UUID  `json:"team_id"`
+	IsSuperOver         bool       `json:"is_super_over"`
+	BattingPosition     *int       `json:"batting_position,omitempty"`
+	RunsScored          int        `json:"runs_scored"`
+	BallsFaced          int        `json:"balls_faced"`
+	Fours               int        `json:"fours"`
+	Sixes               int        `json:"sixes"`
+	DismissalType       *string    `json:"dismissal_type,omitempty"`
+	DismissedByPlayerID *uuid.UUID `json:"dismissed_by_player_id,omitempty"`
+	OversBowled         float64    `json:"overs_bowled"`
+	RunsConceded        int        `json:"runs_conceded"`
+	WicketsTaken        int        `json:"wickets_taken"`
+	Maidens             int        `json:"maidens"`
+	Catches             int        `json:"catches"`
+	RunOuts             int        `json:"run_outs"`
+	Stumpings           int        `json:"stumpings"`
 }
 
 // PlayerCareerStats represents aggregated career statistics for a player
@@ -8,12 +8,18 @@ import (
 	"github.com/cricketapp/backend/internal/statistics/domain"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type statisticsRepository struct {
 	db *sql.DB
 }
 
+// oversBowled converts a bowler's legal balls (bw.legal_balls) to overs in
+// overs.balls notation, so 23 balls are 3.5 overs. div and mod keep the whole
+// overs whole whatever the numeric type of the ball count.
+const oversBowled = `COALESCE(div(bw.legal_balls, 6) + mod(bw.legal_balls, 6) / 10.0, 0)`
+
 // NewStatisticsRepository creates a new statistics repository
 func NewStatisticsRepository(db *sql.DB) domain.StatisticsRepository {
 	return &statisticsRepository{db: db}
@@ -302,6 +308,421 @@ func (r *statisticsRepository) DeletePerformance(performanceID uuid.UUID) error
 	return nil
 }
 
+// RebuildMatchPerformances derives every player's performance for a completed
+// match from its scored deliveries and playing XI, replacing any existing rows.
+// It returns the IDs of all players whose performances were written or removed.
+func (r *statisticsRepository) RebuildMatchPerformances(matchID uuid.UUID) ([]uuid.UUID, error) {
+	var status string
+	err := r.db.QueryRow("SELECT status FROM matches WHERE id = $1", matchID).Scan(&status)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("match not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match: %w", err)
+	}
+	if status != "completed" {
+		return nil, fmt.Errorf("performances can only be built for completed matches")
+	}
+
+	var deliveries int
+	err = r.db.QueryRow("SELECT COUNT(*) FROM match_deliveries WHERE match_id = $1", matchID).Scan(&deliveries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count deliveries: %w", err)
+	}
+
+	// Matches scored without ball-by-ball data keep their manually recorded performances
+	if deliveries == 0 {
+		return nil, nil
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Bowlers are only credited with dismissals they effected; wides and
+	// no-balls are charged to them, byes, leg byes and penalties are not.
+	// Super overs only decide a tie and are kept out of the match totals.
+	query := `
+		WITH d AS (
+			SELECT md.* FROM match_deliveries md
+			LEFT JOIN match_innings mi ON mi.match_id = md.match_id AND mi.innings_number = md.innings
+			WHERE md.match_id = $1 AND mi.is_super_over IS NOT TRUE
+		),
+		participants AS (
+			SELECT DISTINCT ON (player_id) player_id, team_id
+			FROM (
+				SELECT player_id, team_id FROM match_squads WHERE match_id = $1 AND in_playing_11 = true
+				UNION
+				SELECT striker_id, batting_team_id FROM d
+				UNION
+				SELECT non_striker_id, batting_team_id FROM d
+				UNION
+				SELECT bowler_id, bowling_team_id FROM d
+				UNION
+				SELECT fielder_id, bowling_team_id FROM d WHERE fielder_id IS NOT NULL
+			) p
+			ORDER BY player_id
+		),
+		appearances AS (
+			SELECT striker_id AS player_id, batting_team_id AS team_id, sequence * 2 AS arrived FROM d
+			UNION ALL
+			SELECT non_striker_id, batting_team_id, sequence * 2 + 1 FROM d
+		),
+		batting_order AS (
+			SELECT player_id,
+				ROW_NUMBER() OVER (PARTITION BY team_id ORDER BY MIN(arrived)) AS batting_position
+			FROM appearances
+			GROUP BY player_id, team_id
+		),
+		batting AS (
+			SELECT striker_id AS player_id,
+				SUM(runs_off_bat) AS runs_scored,
+				COUNT(*) FILTER (WHERE extra_type IS DISTINCT FROM 'wide') AS balls_faced,
+				COUNT(*) FILTER (WHERE runs_off_bat = 4) AS fours,
+				COUNT(*) FILTER (WHERE runs_off_bat = 6) AS sixes
+			FROM d
+			GROUP BY striker_id
+		),
+		dismissals AS (
+			SELECT DISTINCT ON (dismissed_player_id)
+				dismissed_player_id AS player_id, wicket_type,
+				CASE
+					WHEN wicket_type IN ('bowled', 'caught', 'lbw', 'stumped', 'hit_wicket') THEN bowler_id
+					WHEN wicket_type = 'run_out' THEN fielder_id
+				END AS dismissed_by_player_id
+			FROM d
+			WHERE is_wicket = true AND dismissed_player_id IS NOT NULL
+			ORDER BY dismissed_player_id, sequence DESC
+		),
+		overs AS (
+			SELECT bowler_id, innings, over_number,
+				COUNT(*) FILTER (WHERE extra_type IS NULL OR extra_type NOT IN ('wide', 'no_ball')) AS legal_balls,
+				SUM(runs_off_bat + CASE WHEN extra_type IN ('wide', 'no_ball') THEN extras ELSE 0 END) AS runs_conceded,
+				COUNT(*) FILTER (WHERE is_wicket = true AND wicket_type IN ('bowled', 'caught', 'lbw', 'stumped', 'hit_wicket')) AS wickets_taken
+			FROM d
+			GROUP BY bowler_id, innings, over_number
+		),
+		bowling AS (
+			SELECT bowler_id AS player_id,
+				SUM(legal_balls) AS legal_balls,
+				SUM(runs_conceded) AS runs_conceded,
+				SUM(wickets_taken) AS wickets_taken,
+				COUNT(*) FILTER (WHERE legal_balls = 6 AND runs_conceded = 0) AS maidens
+			FROM overs
+			GROUP BY bowler_id
+		),
+		fielding AS (
+			SELECT fielder_id AS player_id,
+				COUNT(*) FILTER (WHERE wicket_type = 'caught') AS catches,
+				COUNT(*) FILTER (WHERE wicket_type = 'run_out') AS run_outs,
+				COUNT(*) FILTER (WHERE wicket_type = 'stumped') AS stumpings
+			FROM d
+			WHERE is_wicket = true AND fielder_id IS NOT NULL
+			GROUP BY fielder_id
+		)
+		INSERT INTO player_match_performances (
+			player_id, match_id, team_id, played, captain, vice_captain, wicket_keeper,
+			batting_position, runs_scored, balls_faced, fours, sixes, strike_rate,
+			dismissal_type, dismissed_by_player_id,
+			overs_bowled, runs_conceded, wickets_taken, maidens, economy_rate, bowling_strike_rate,
+			catches, run_outs, stumpings
+		)
+		SELECT
+			p.player_id, $1, p.team_id, true,
+			COALESCE(sq.is_captain, false),
+			COALESCE(sq.is_vice_captain, false),
+			COALESCE(sq.is_wicket_keeper, false),
+			bo.batting_position,
+			COALESCE(b.runs_scored, 0),
+			COALESCE(b.balls_faced, 0),
+			COALESCE(b.fours, 0),
+			COALESCE(b.sixes, 0),
+			CASE WHEN b.balls_faced > 0
+				THEN (CAST(b.runs_scored AS DECIMAL) / b.balls_faced) * 100
+				ELSE 0 END,
+			CASE
+				WHEN dm.player_id IS NOT NULL THEN dm.wicket_type
+				WHEN bo.player_id IS NOT NULL THEN 'not_out'
+			END,
+			dm.dismissed_by_player_id,
+			` + oversBowled + `,
+			COALESCE(bw.runs_conceded, 0),
+			COALESCE(bw.wickets_taken, 0),
+			COALESCE(bw.maidens, 0),
+			CASE WHEN bw.legal_balls > 0
+				THEN CAST(bw.runs_conceded AS DECIMAL) * 6 / bw.legal_balls
+				ELSE 0 END,
+			CASE WHEN bw.wickets_taken > 0
+				THEN CAST(bw.legal_balls AS DECIMAL) / bw.wickets_taken
+				ELSE 0 END,
+			COALESCE(f.catches, 0),
+			COALESCE(f.run_outs, 0),
+			COALESCE(f.stumpings, 0)
+		FROM participants p
+		LEFT JOIN match_squads sq ON sq.match_id = $1 AND sq.player_id = p.player_id
+		LEFT JOIN batting_order bo ON bo.player_id = p.player_id
+		LEFT JOIN batting b ON b.player_id = p.player_id
+		LEFT JOIN dismissals dm ON dm.player_id = p.player_id
+		LEFT JOIN bowling bw ON bw.player_id = p.player_id
+		LEFT JOIN fielding f ON f.player_id = p.player_id
+		ON CONFLICT (player_id, match_id) DO UPDATE SET
+			team_id = EXCLUDED.team_id,
+			played = EXCLUDED.played,
+			captain = EXCLUDED.captain,
+			vice_captain = EXCLUDED.vice_captain,
+			wicket_keeper = EXCLUDED.wicket_keeper,
+			batting_position = EXCLUDED.batting_position,
+			runs_scored = EXCLUDED.runs_scored,
+			balls_faced = EXCLUDED.balls_faced,
+			fours = EXCLUDED.fours,
+			sixes = EXCLUDED.sixes,
+			strike_rate = EXCLUDED.strike_rate,
+			dismissal_type = EXCLUDED.dismissal_type,
+			dismissed_by_player_id = EXCLUDED.dismissed_by_player_id,
+			overs_bowled = EXCLUDED.overs_bowled,
+			runs_conceded = EXCLUDED.runs_conceded,
+			wickets_taken = EXCLUDED.wickets_taken,
+			maidens = EXCLUDED.maidens,
+			economy_rate = EXCLUDED.economy_rate,
+			bowling_strike_rate = EXCLUDED.bowling_strike_rate,
+			catches = EXCLUDED.catches,
+			run_outs = EXCLUDED.run_outs,
+			stumpings = EXCLUDED.stumpings,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING player_id`
+
+	rows, err := tx.Query(query, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build performances: %w", err)
+	}
+
+	var playerIDs []uuid.UUID
+	var written []string
+	for rows.Next() {
+		var playerID uuid.UUID
+		if err := rows.Scan(&playerID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan player: %w", err)
+		}
+		playerIDs = append(playerIDs, playerID)
+		written = append(written, playerID.String())
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to build performances: %w", err)
+	}
+
+	// Drop rows for players who no longer appear in the scoring data
+	rows, err = tx.Query(`
+		DELETE FROM player_match_performances
+		WHERE match_id = $1 AND NOT (player_id = ANY($2::uuid[]))
+		RETURNING player_id`, matchID, pq.Array(written))
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove stale performances: %w", err)
+	}
+	for rows.Next() {
+		var playerID uuid.UUID
+		if err := rows.Scan(&playerID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan player: %w", err)
+		}
+		playerIDs = append(playerIDs, playerID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to remove stale performances: %w", err)
+	}
+
+	if err := rebuildInningsPerformances(tx, matchID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit performances: %w", err)
+	}
+
+	return playerIDs, nil
+}
+
+// rebuildInningsPerformances replaces the per-innings split of a match, with
+// a row for every player who batted, bowled or took part in a dismissal in
+// each innings, super overs included
+func rebuildInningsPerformances(tx *sql.Tx, matchID uuid.UUID) error {
+	if _, err := tx.Exec("DELETE FROM player_innings_performances WHERE match_id = $1", matchID); err != nil {
+		return fmt.Errorf("failed to clear innings performances: %w", err)
+	}
+
+	query := `
+		WITH d AS (
+			SELECT md.*, COALESCE(mi.is_super_over, false) AS is_super_over
+			FROM match_deliveries md
+			LEFT JOIN match_innings mi ON mi.match_id = md.match_id AND mi.innings_number = md.innings
+			WHERE md.match_id = $1
+		),
+		appearances AS (
+			SELECT innings, striker_id AS player_id, batting_team_id AS team_id, sequence * 2 AS arrived FROM d
+			UNION ALL
+			SELECT innings, non_striker_id, batting_team_id, sequence * 2 + 1 FROM d
+		),
+		batting_order AS (
+			SELECT innings, player_id, team_id,
+				ROW_NUMBER() OVER (PARTITION BY innings ORDER BY MIN(arrived)) AS batting_position
+			FROM appearances
+			GROUP BY innings, player_id, team_id
+		),
+		participants AS (
+			SELECT DISTINCT ON (innings, player_id) innings, player_id, team_id
+			FROM (
+				SELECT innings, player_id, team_id FROM batting_order
+				UNION
+				SELECT innings, bowler_id, bowling_team_id FROM d
+				UNION
+				SELECT innings, fielder_id, bowling_team_id FROM d WHERE is_wicket = true AND fielder_id IS NOT NULL
+			) p
+			ORDER BY innings, player_id
+		),
+		super_overs AS (
+			SELECT DISTINCT innings, is_super_over FROM d
+		),
+		batting AS (
+			SELECT innings, striker_id AS player_id,
+				SUM(runs_off_bat) AS runs_scored,
+				COUNT(*) FILTER (WHERE extra_type IS DISTINCT FROM 'wide') AS balls_faced,
+				COUNT(*) FILTER (WHERE runs_off_bat = 4) AS fours,
+				COUNT(*) FILTER (WHERE runs_off_bat = 6) AS sixes
+			FROM d
+			GROUP BY innings, striker_id
+		),
+		dismissals AS (
+			SELECT DISTINCT ON (innings, dismissed_player_id)
+				innings, dismissed_player_id AS player_id, wicket_type,
+				CASE
+					WHEN wicket_type IN ('bowled', 'caught', 'lbw', 'stumped', 'hit_wicket') THEN bowler_id
+					WHEN wicket_type = 'run_out' THEN fielder_id
+				END AS dismissed_by_player_id
+			FROM d
+			WHERE is_wicket = true AND dismissed_player_id IS NOT NULL
+			ORDER BY innings, dismissed_player_id, sequence DESC
+		),
+		overs AS (
+			SELECT bowler_id, innings, over_number,
+				COUNT(*) FILTER (WHERE extra_type IS NULL OR extra_type NOT IN ('wide', 'no_ball')) AS legal_balls,
+				SUM(runs_off_bat + CASE WHEN extra_type IN ('wide', 'no_ball') THEN extras ELSE 0 END) AS runs_conceded,
+				COUNT(*) FILTER (WHERE is_wicket = true AND wicket_type IN ('bowled', 'caught', 'lbw', 'stumped', 'hit_wicket')) AS wickets_taken
+			FROM d
+			GROUP BY bowler_id, innings, over_number
+		),
+		bowling AS (
+			SELECT innings, bowler_id AS player_id,
+				SUM(legal_balls) AS legal_balls,
+				SUM(runs_conceded) AS runs_conceded,
+				SUM(wickets_taken) AS wickets_taken,
+				COUNT(*) FILTER (WHERE legal_balls = 6 AND runs_conceded = 0) AS maidens
+			FROM overs
+			GROUP BY innings, bowler_id
+		),
+		fielding AS (
+			SELECT innings, fielder_id AS player_id,
+				COUNT(*) FILTER (WHERE wicket_type = 'caught') AS catches,
+				COUNT(*) FILTER (WHERE wicket_type = 'run_out') AS run_outs,
+				COUNT(*) FILTER (WHERE wicket_type = 'stumped') AS stumpings
+			FROM d
+			WHERE is_wicket = true AND fielder_id IS NOT NULL
+			GROUP BY innings, fielder_id
+		)
+		INSERT INTO player_innings_performances (
+			player_id, match_id, innings_number, team_id, is_super_over,
+			batting_position, runs_scored, balls_faced, fours, sixes,
+			dismissal_type, dismissed_by_player_id,
+			overs_bowled, runs_conceded, wickets_taken, maidens,
+			catches, run_outs, stumpings
+		)
+		SELECT
+			p.player_id, $1, p.innings, p.team_id, so.is_super_over,
+			bo.batting_position,
+			COALESCE(b.runs_scored, 0),
+			COALESCE(b.balls_faced, 0),
+			COALESCE(b.fours, 0),
+			COALESCE(b.sixes, 0),
+			CASE
+				WHEN dm.player_id IS NOT NULL THEN dm.wicket_type
+				WHEN bo.player_id IS NOT NULL THEN 'not_out'
+			END,
+			dm.dismissed_by_player_id,
+			` + oversBowled + `,
+			COALESCE(bw.runs_conceded, 0),
+			COALESCE(bw.wickets_taken, 0),
+			COALESCE(bw.maidens, 0),
+			COALESCE(f.catches, 0),
+			COALESCE(f.run_outs, 0),
+			COALESCE(f.stumpings, 0)
+		FROM participants p
+		JOIN super_overs so ON so.innings = p.innings
+		LEFT JOIN batting_order bo ON bo.innings = p.innings AND bo.player_id = p.player_id
+		LEFT JOIN batting b ON b.innings = p.innings AND b.player_id = p.player_id
+		LEFT JOIN dismissals dm ON dm.innings = p.innings AND dm.player_id = p.player_id
+		LEFT JOIN bowling bw ON bw.innings = p.innings AND bw.player_id = p.player_id
+		LEFT JOIN fielding f ON f.innings = p.innings AND f.player_id = p.player_id`
+
+	if _, err := tx.Exec(query, matchID); err != nil {
+		return fmt.Errorf("failed to build innings performances: %w", err)
+	}
+
+	return nil
+}
+
+// ListInningsPerformances retrieves a player's per-innings split for a match
+func (r *statisticsRepository) ListInningsPerformances(playerID, matchID uuid.UUID) ([]domain.PlayerInningsPerformance, error) {
+	query := `
+		SELECT id, player_id, match_id, innings_number, team_id, is_super_over,
+			   batting_position, runs_scored, balls_faced, fours, sixes,
+			   dismissal_type, dismissed_by_player_id,
+			   overs_bowled, runs_conceded, wickets_taken, maidens,
+			   catches, run_outs, stumpings
+		FROM player_innings_performances
+		WHERE player_id = $1 AND match_id = $2
+		ORDER BY innings_number`
+
+	rows, err := r.db.Query(query, playerID, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list innings performances: %w", err)
+	}
+	defer rows.Close()
+
+	var innings []domain.PlayerInningsPerformance
+	for rows.Next() {
+		var perf domain.PlayerInningsPerformance
+		err := rows.Scan(
+			&perf.ID, &perf.PlayerID, &perf.MatchID, &perf.InningsNumber, &perf.TeamID, &perf.IsSuperOver,
+			&perf.BattingPosition, &perf.RunsScored, &perf.BallsFaced, &perf.Fours, &perf.Sixes,
+			&perf.DismissalType, &perf.DismissedByPlayerID,
+			&perf.OversBowled, &perf.RunsConceded, &perf.WicketsTaken, &perf.Maidens,
+			&perf.Catches, &perf.RunOuts, &perf.Stumpings,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan innings performance: %w", err)
+		}
+		innings = append(innings, perf)
+	}
+
+	return innings, rows.Err()
+}
+
+// GetMatchCreator retrieves the user who created a match
+func (r *statisticsRepository) GetMatchCreator(matchID uuid.UUID) (uuid.UUID, error) {
+	var createdBy uuid.NullUUID
+	err := r.db.QueryRow("SELECT created_by FROM matches WHERE id = $1", matchID).Scan(&createdBy)
+	if err == sql.ErrNoRows {
+		return uuid.Nil, fmt.Errorf("match not found")
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get match: %w", err)
+	}
+	return createdBy.UUID, nil
+}
+
 // GetCareerStats retrieves career stats for a player
 func (r *statisticsRepository) GetCareerStats(playerID uuid.UUID) (*domain.PlayerCareerStats, error) {
 	query := `
@@ -8,27 +8,33 @@ import (
 	"github.com/google/uuid"
 )
 
+// validDismissals are the ways a batter's innings can end, or not_out
+var validDismissals = map[string]bool{
+	"bowled": true, "caught": true, "lbw": true, "run_out": true, "stumped": true,
+	"hit_wicket": true, "retired_hurt": true, "retired_out": true, "not_out": true, "timed_out": true,
+	"obstructing": true, "hit_twice": true,
+}
+
 type statisticsService struct {
-	repo domain.StatisticsRepository
+	repo        domain.StatisticsRepository
+	matchLimits domain.MatchLimitsLookup
 }
 
-// NewStatisticsService creates a new statistics service
-func NewStatisticsService(repo domain.StatisticsRepository) domain.StatisticsService {
-	return &statisticsService{repo: repo}
+// NewStatisticsService creates a new statistics service. matchLimits resolves
+// the rules recorded performances are checked against.
+func NewStatisticsService(repo domain.StatisticsRepository, matchLimits domain.MatchLimitsLookup) domain.StatisticsService {
+	return &statisticsService{repo: repo, matchLimits: matchLimits}
 }
 
 // RecordPerformance records a player's match performance
-func (s *statisticsService) RecordPerformance(req domain.RecordPerformanceRequest) (*domain.PlayerMatchPerformance, error) {
+func (s *statisticsService) RecordPerformance(req domain.RecordPerformanceRequest, userID uuid.UUID) (*domain.PlayerMatchPerformance, error) {
+	if err := s.checkMatchScorer(req.MatchID, userID); err != nil {
+		return nil, err
+	}
+
 	// Validate dismissal type
-	if req.DismissalType != nil {
-		validDismissals := map[string]bool{
-			"bowled": true, "caught": true, "lbw": true, "run_out": true, "stumped": true,
-			"hit_wicket": true, "retired_hurt": true, "not_out": true, "timed_out": true,
-			"obstructing": true, "hit_twice": true,
-		}
-		if !validDismissals[*req.DismissalType] {
-			return nil, fmt.Errorf("invalid dismissal type: %s", *req.DismissalType)
-		}
+	if req.DismissalType != nil && !validDismissals[*req.DismissalType] {
+		return nil, fmt.Errorf("invalid dismissal type: %s", *req.DismissalType)
 	}
 
 	// Validate batting stats
@@ -46,6 +52,10 @@ func (s *statisticsService) RecordPerformance(req domain.RecordPerformanceReques
 		return nil, fmt.Errorf("fielding stats cannot be negative")
 	}
 
+	if err := s.checkMatchLimits(req.MatchID, &req.OversBowled, req.DismissalType); err != nil {
+		return nil, err
+	}
+
 	perf := &domain.PlayerMatchPerformance{
 		PlayerID:            req.PlayerID,
 		MatchID:             req.MatchID,
@@ -79,14 +89,32 @@ func (s *statisticsService) RecordPerformance(req domain.RecordPerformanceReques
 	return perf, nil
 }
 
-// GetPerformance retrieves a performance by ID
+// GetPerformance retrieves a performance by ID, with its innings breakdown
 func (s *statisticsService) GetPerformance(performanceID uuid.UUID) (*domain.PlayerMatchPerformance, error) {
-	return s.repo.GetPerformance(performanceID)
+	perf, err := s.repo.GetPerformance(performanceID)
+	if err != nil {
+		return nil, err
+	}
+	return s.withInnings(perf)
 }
 
-// GetPlayerMatchPerformance retrieves a performance by player and match
+// GetPlayerMatchPerformance retrieves a performance by player and match, with its innings breakdown
 func (s *statisticsService) GetPlayerMatchPerformance(playerID, matchID uuid.UUID) (*domain.PlayerMatchPerformance, error) {
-	return s.repo.GetPerformanceByPlayerMatch(playerID, matchID)
+	perf, err := s.repo.GetPerformanceByPlayerMatch(playerID, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return s.withInnings(perf)
+}
+
+// withInnings attaches the per-innings split of a performance
+func (s *statisticsService) withInnings(perf *domain.PlayerMatchPerformance) (*domain.PlayerMatchPerformance, error) {
+	innings, err := s.repo.ListInningsPerformances(perf.PlayerID, perf.MatchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get innings performances: %w", err)
+	}
+	perf.Innings = innings
+	return perf, nil
 }
 
 // ListPerformances lists performances with filters
@@ -95,7 +123,15 @@ func (s *statisticsService) ListPerformances(filters domain.PerformanceFilters)
 }
 
 // UpdatePerformance updates a performance
-func (s *statisticsService) UpdatePerformance(performanceID uuid.UUID, req domain.UpdatePerformanceRequest) (*domain.PlayerMatchPerformance, error) {
+func (s *statisticsService) UpdatePerformance(performanceID uuid.UUID, req domain.UpdatePerformanceRequest, userID uuid.UUID) (*domain.PlayerMatchPerformance, error) {
+	perf, err := s.repo.GetPerformance(performanceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkMatchScorer(perf.MatchID, userID); err != nil {
+		return nil, err
+	}
+
 	updates := make(map[string]interface{})
 
 	if req.Played != nil {
@@ -138,6 +174,9 @@ func (s *statisticsService) UpdatePerformance(performanceID uuid.UUID, req domai
 		updates["sixes"] = *req.Sixes
 	}
 	if req.DismissalType != nil {
+		if !validDismissals[*req.DismissalType] {
+			return nil, fmt.Errorf("invalid dismissal type: %s", *req.DismissalType)
+		}
 		updates["dismissal_type"] = *req.DismissalType
 	}
 	if req.DismissedByPlayerID != nil {
@@ -193,49 +232,47 @@ func (s *statisticsService) UpdatePerformance(performanceID uuid.UUID, req domai
 		return s.repo.GetPerformance(performanceID)
 	}
 
+	if err := s.checkMatchLimits(perf.MatchID, req.OversBowled, req.DismissalType); err != nil {
+		return nil, err
+	}
+
 	// Recalculate rates if batting/bowling stats changed
 	if req.BallsFaced != nil || req.RunsScored != nil {
-		perf, err := s.repo.GetPerformance(performanceID)
-		if err == nil {
-			runs := perf.RunsScored
-			balls := perf.BallsFaced
-			if req.RunsScored != nil {
-				runs = *req.RunsScored
-			}
-			if req.BallsFaced != nil {
-				balls = *req.BallsFaced
-			}
-			if balls > 0 {
-				updates["strike_rate"] = (float64(runs) / float64(balls)) * 100
-			}
+		runs := perf.RunsScored
+		balls := perf.BallsFaced
+		if req.RunsScored != nil {
+			runs = *req.RunsScored
+		}
+		if req.BallsFaced != nil {
+			balls = *req.BallsFaced
+		}
+		if balls > 0 {
+			updates["strike_rate"] = (float64(runs) / float64(balls)) * 100
 		}
 	}
 
 	if req.OversBowled != nil || req.RunsConceded != nil || req.WicketsTaken != nil {
-		perf, err := s.repo.GetPerformance(performanceID)
-		if err == nil {
-			overs := perf.OversBowled
-			runs := perf.RunsConceded
-			wickets := perf.WicketsTaken
-			if req.OversBowled != nil {
-				overs = *req.OversBowled
-			}
-			if req.RunsConceded != nil {
-				runs = *req.RunsConceded
-			}
-			if req.WicketsTaken != nil {
-				wickets = *req.WicketsTaken
-			}
-			if overs > 0 {
-				updates["economy_rate"] = float64(runs) / overs
-				if wickets > 0 {
-					updates["bowling_strike_rate"] = (overs * 6) / float64(wickets)
-				}
+		overs := perf.OversBowled
+		runs := perf.RunsConceded
+		wickets := perf.WicketsTaken
+		if req.OversBowled != nil {
+			overs = *req.OversBowled
+		}
+		if req.RunsConceded != nil {
+			runs = *req.RunsConceded
+		}
+		if req.WicketsTaken != nil {
+			wickets = *req.WicketsTaken
+		}
+		if overs > 0 {
+			updates["economy_rate"] = float64(runs) / overs
+			if wickets > 0 {
+				updates["bowling_strike_rate"] = (overs * 6) / float64(wickets)
 			}
 		}
 	}
 
-	err := s.repo.UpdatePerformance(performanceID, updates)
+	err = s.repo.UpdatePerformance(performanceID, updates)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update performance: %w", err)
 	}
@@ -244,10 +281,78 @@ func (s *statisticsService) UpdatePerformance(performanceID uuid.UUID, req domai
 }
 
 // DeletePerformance deletes a performance
-func (s *statisticsService) DeletePerformance(performanceID uuid.UUID) error {
+func (s *statisticsService) DeletePerformance(performanceID, userID uuid.UUID) error {
+	perf, err := s.repo.GetPerformance(performanceID)
+	if err != nil {
+		return err
+	}
+	if err := s.checkMatchScorer(perf.MatchID, userID); err != nil {
+		return err
+	}
+
 	return s.repo.DeletePerformance(performanceID)
 }
 
+// checkMatchLimits rejects overs beyond the bowler's quota and a retired out
+// dismissal where the match's rules do not allow it
+func (s *statisticsService) checkMatchLimits(matchID uuid.UUID, oversBowled *float64, dismissalType *string) error {
+	limits, err := s.matchLimits(matchID)
+	if err != nil {
+		return err
+	}
+
+	if oversBowled != nil && limits.BowlerQuota > 0 && *oversBowled > float64(limits.BowlerQuota) {
+		unit := "overs"
+		if limits.BowlerQuota == 1 {
+			unit = "over"
+		}
+		return fmt.Errorf("overs bowled cannot exceed the bowler quota of %d %s", limits.BowlerQuota, unit)
+	}
+	if dismissalType != nil && *dismissalType == "retired_out" && !limits.RetiredOut {
+		return fmt.Errorf("retired out is not allowed under this match's rules")
+	}
+	return nil
+}
+
+// checkMatchScorer allows only the user who created a match, who scores it,
+// to record and correct its performances
+func (s *statisticsService) checkMatchScorer(matchID, userID uuid.UUID) error {
+	createdBy, err := s.repo.GetMatchCreator(matchID)
+	if err != nil {
+		return err
+	}
+	if createdBy != userID {
+		return fmt.Errorf("not authorized to manage performances for this match")
+	}
+	return nil
+}
+
+// BuildMatchPerformances derives the performances of a completed match from its
+// ball-by-ball data and refreshes the career stats of every affected player
+func (s *statisticsService) BuildMatchPerformances(matchID uuid.UUID) ([]domain.PlayerMatchPerformance, error) {
+	playerIDs, err := s.repo.RebuildMatchPerformances(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, playerID := range playerIDs {
+		if err := s.repo.RecalculateCareerStats(playerID); err != nil {
+			return nil, fmt.Errorf("failed to refresh stats for player %s: %w", playerID, err)
+		}
+	}
+
+	performances, _, err := s.repo.ListPerformances(domain.PerformanceFilters{
+		MatchID: &matchID,
+		Page:    1,
+		Limit:   100,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return performances, nil
+}
+
 // GetPlayerCareerStats retrieves career stats for a player
 func (s *statisticsService) GetPlayerCareerStats(playerID uuid.UUID) (*domain.PlayerCareerStats, error) {
 	return s.repo.GetCareerStats(playerID)
@@ -19,10 +19,12 @@ type TournamentRepository interface {
 	// Registration operations
 	RegisterTeam(ctx context.Context, registration *TournamentRegistration) error
 	GetRegistration(ctx context.Context, tournamentID, teamID uuid.UUID) (*TournamentRegistration, error)
+	GetRegistrationByID(ctx context.Context, registrationID uuid.UUID) (*TournamentRegistration, error)
 	ListRegistrations(ctx context.Context, tournamentID uuid.UUID, status *string) ([]TournamentRegistration, error)
 	UpdateRegistrationStatus(ctx context.Context, registrationID uuid.UUID, status string, approvedBy uuid.UUID, rejectionReason *string) error
 	WithdrawRegistration(ctx context.Context, registrationID uuid.UUID) error
 	GetRegistrationCount(ctx context.Context, tournamentID uuid.UUID, status *string) (int, error)
+	IsTeamManager(ctx context.Context, teamID, userID uuid.UUID) (bool, error)
 
 	// Standings operations
 	CreateOrUpdateStanding(ctx context.Context, standing *TournamentStanding) error
@@ -34,7 +36,19 @@ type TournamentRepository interface {
 	LinkMatchToTournament(ctx context.Context, tournamentMatch *TournamentMatch) error
 	GetTournamentMatches(ctx context.Context, tournamentID uuid.UUID, roundNumber *int, groupName *string) ([]TournamentMatch, error)
 	GetTournamentMatch(ctx context.Context, tournamentID, matchID uuid.UUID) (*TournamentMatch, error)
+	GetTournamentMatchByMatchID(ctx context.Context, matchID uuid.UUID) (*TournamentMatch, error)
 	UpdateTournamentMatch(ctx context.Context, tournamentMatchID uuid.UUID, tournamentMatch *TournamentMatch) error
+	CreateFixtures(ctx context.Context, fixtures []Fixture) error
+
+	// Knockout operations
+	GetKnockoutResult(ctx context.Context, matchID uuid.UUID) (*KnockoutResult, error)
+	AdvanceKnockoutWinner(ctx context.Context, tournamentMatch *TournamentMatch, winnerTeamID uuid.UUID, resolution string) error
+	GetBracketMatches(ctx context.Context, tournamentID uuid.UUID) ([]BracketMatch, error)
+	CountOpenGroupMatches(ctx context.Context, tournamentID uuid.UUID) (int, error)
+	SeedKnockoutMatch(ctx context.Context, matchID, teamAID, teamBID uuid.UUID) error
+
+	// Team lookups
+	GetTeamNames(ctx context.Context, teamIDs []uuid.UUID) (map[uuid.UUID]string, error)
 }
 
 // TournamentFilters for filtering tournaments
@@ -0,0 +1,256 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Rule keys read from Tournament.Rules
+const (
+	RulePointsPerWin      = "points_per_win"
+	RulePointsPerLoss     = "points_per_loss"
+	RulePointsPerTie      = "points_per_tie"
+	RulePointsPerDraw     = "points_per_draw"
+	RulePointsPerNoResult = "points_per_no_result"
+	RulePointsPerBonus    = "points_per_bonus"
+	RuleBonusRunRate      = "bonus_point_run_rate_factor"
+	RuleTieBreakers       = "tie_breaker_rules"
+
+	RuleDoubleRoundRobin = "double_round_robin"
+	RuleGroups           = "groups"
+	RuleSeeds            = "seeds"
+	RuleMatchTimes       = "match_times"
+	RuleOversPerInnings  = "overs_per_innings"
+
+	RuleKnockoutTieResolution = "knockout_tie_resolution"
+	// Teams of each group that reach the knockout stage of a mixed tournament
+	RuleQualifiersPerGroup = "qualifiers_per_group"
+
+	// Overrides of the match format's rules profile, applied by the match service
+	RuleMaxOversPerBowler = "max_overs_per_bowler"
+	RulePowerplayOvers    = "powerplay_overs"
+	RuleWideRuns          = "wide_runs"
+	RuleNoBallRuns        = "no_ball_runs"
+	RuleFreeHit           = "free_hit"
+	RuleRetiredOut        = "retired_out"
+	RulePlayersPerSide    = "players_per_side"
+	RuleSquadSize         = "squad_size"
+	RuleFollowOnMargin    = "follow_on_margin"
+)
+
+// Ways a tied or abandoned knockout match can be decided
+const (
+	ResolutionResult     = "result"
+	ResolutionSuperOver  = "super_over"
+	ResolutionHigherSeed = "higher_seed"
+	ResolutionCoinToss   = "coin_toss"
+)
+
+var validTieResolutions = map[string]bool{
+	ResolutionSuperOver:  true,
+	ResolutionHigherSeed: true,
+	ResolutionCoinToss:   true,
+}
+
+// Tie breakers applied, in order, when teams finish level on points
+const (
+	TieBreakerWins         = "wins"
+	TieBreakerNetRunRate   = "net_run_rate"
+	TieBreakerHeadToHead   = "head_to_head"
+	TieBreakerRunsScored   = "runs_scored"
+	TieBreakerWicketsTaken = "wickets_taken"
+)
+
+var validTieBreakers = map[string]bool{
+	TieBreakerWins:         true,
+	TieBreakerNetRunRate:   true,
+	TieBreakerHeadToHead:   true,
+	TieBreakerRunsScored:   true,
+	TieBreakerWicketsTaken: true,
+}
+
+// PointsRules controls how results are turned into standings
+type PointsRules struct {
+	Win      int
+	Loss     int
+	Tie      int
+	Draw     int
+	NoResult int
+
+	// A winner earns Bonus extra points when its run rate is at least
+	// BonusRunRateFactor times the loser's. A factor of 0 disables bonus points.
+	Bonus              int
+	BonusRunRateFactor float64
+
+	TieBreakers []string
+}
+
+// PointsRules returns the tournament's points configuration, falling back to
+// the usual 2 for a win, 1 for a tie or no result
+func (t *Tournament) PointsRules() PointsRules {
+	draw := t.IntRule(RulePointsPerDraw, 1)
+	return PointsRules{
+		Win:                t.IntRule(RulePointsPerWin, 2),
+		Loss:               t.IntRule(RulePointsPerLoss, 0),
+		Tie:                t.IntRule(RulePointsPerTie, draw),
+		Draw:               draw,
+		NoResult:           t.IntRule(RulePointsPerNoResult, 1),
+		Bonus:              t.IntRule(RulePointsPerBonus, 1),
+		BonusRunRateFactor: t.FloatRule(RuleBonusRunRate, 0),
+		TieBreakers: t.StringListRule(RuleTieBreakers, []string{
+			TieBreakerWins, TieBreakerNetRunRate, TieBreakerHeadToHead,
+		}),
+	}
+}
+
+// ValidateRules checks the rule values the application interprets
+func ValidateRules(rules map[string]interface{}) error {
+	t := &Tournament{Rules: rules}
+
+	for _, key := range []string{RulePointsPerWin, RulePointsPerLoss, RulePointsPerTie, RulePointsPerDraw, RulePointsPerNoResult, RulePointsPerBonus} {
+		if v, ok := rules[key]; ok {
+			if _, isNumber := v.(float64); !isNumber {
+				return fmt.Errorf("rule %s must be a number", key)
+			}
+		}
+	}
+
+	if t.FloatRule(RuleBonusRunRate, 0) < 0 {
+		return fmt.Errorf("rule %s cannot be negative", RuleBonusRunRate)
+	}
+
+	if v, ok := rules[RuleTieBreakers]; ok {
+		list, isList := v.([]interface{})
+		if !isList {
+			return fmt.Errorf("rule %s must be a list", RuleTieBreakers)
+		}
+		for _, item := range list {
+			name, _ := item.(string)
+			if !validTieBreakers[name] {
+				return fmt.Errorf("invalid tie breaker: %v", item)
+			}
+		}
+	}
+
+	if t.IntRule(RuleGroups, 1) < 1 {
+		return fmt.Errorf("rule %s must be at least 1", RuleGroups)
+	}
+
+	if t.IntRule(RuleQualifiersPerGroup, 1) < 1 {
+		return fmt.Errorf("rule %s must be at least 1", RuleQualifiersPerGroup)
+	}
+
+	if t.IntRule(RuleOversPerInnings, 1) < 1 {
+		return fmt.Errorf("rule %s must be at least 1", RuleOversPerInnings)
+	}
+
+	for _, key := range []string{RuleMaxOversPerBowler, RulePowerplayOvers, RuleWideRuns, RuleNoBallRuns, RulePlayersPerSide, RuleSquadSize, RuleFollowOnMargin} {
+		if v, ok := rules[key]; ok {
+			if _, isNumber := v.(float64); !isNumber {
+				return fmt.Errorf("rule %s must be a number", key)
+			}
+			if t.IntRule(key, 0) < 0 {
+				return fmt.Errorf("rule %s cannot be negative", key)
+			}
+		}
+	}
+
+	for _, key := range []string{RuleFreeHit, RuleRetiredOut} {
+		if v, ok := rules[key]; ok {
+			if _, isBool := v.(bool); !isBool {
+				return fmt.Errorf("rule %s must be true or false", key)
+			}
+		}
+	}
+
+	players := t.IntRule(RulePlayersPerSide, 11)
+	if players < 2 {
+		return fmt.Errorf("rule %s must be at least 2", RulePlayersPerSide)
+	}
+	if t.IntRule(RuleSquadSize, players) < players {
+		return fmt.Errorf("rule %s cannot be less than %s", RuleSquadSize, RulePlayersPerSide)
+	}
+
+	for _, seed := range t.StringListRule(RuleSeeds, nil) {
+		if _, err := uuid.Parse(seed); err != nil {
+			return fmt.Errorf("invalid seed team id: %s", seed)
+		}
+	}
+
+	if v, ok := rules[RuleKnockoutTieResolution]; ok {
+		method, _ := v.(string)
+		if !validTieResolutions[method] {
+			return fmt.Errorf("invalid knockout tie resolution: %v", v)
+		}
+	}
+
+	for _, matchTime := range t.StringListRule(RuleMatchTimes, nil) {
+		if _, err := time.Parse("15:04", matchTime); err != nil {
+			return fmt.Errorf("invalid match time %s, expected HH:MM", matchTime)
+		}
+	}
+
+	return nil
+}
+
+// ValidTieResolution reports whether method can decide a tied knockout match
+func ValidTieResolution(method string) bool {
+	return validTieResolutions[method]
+}
+
+// IntRule returns an integer rule, or def when it is missing or not a number
+func (t *Tournament) IntRule(key string, def int) int {
+	switch v := t.Rules[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return def
+}
+
+// FloatRule returns a numeric rule, or def when it is missing or not a number
+func (t *Tournament) FloatRule(key string, def float64) float64 {
+	switch v := t.Rules[key].(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	}
+	return def
+}
+
+// StringRule returns a string rule, or def when it is missing or not a string
+func (t *Tournament) StringRule(key string, def string) string {
+	if v, ok := t.Rules[key].(string); ok {
+		return v
+	}
+	return def
+}
+
+// StringListRule returns a list of strings rule, or def when it is missing
+func (t *Tournament) StringListRule(key string, def []string) []string {
+	switch v := t.Rules[key].(type) {
+	case []string:
+		return v
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+	return def
+}
+
+// BoolRule returns a boolean rule, or def when it is missing or not a boolean
+func (t *Tournament) BoolRule(key string, def bool) bool {
+	if v, ok := t.Rules[key].(bool); ok {
+		return v
+	}
+	return def
+}
@@ -33,8 +33,13 @@ type TournamentService interface {
 	// Standings operations
 	GetStandings(ctx context.Context, tournamentID uuid.UUID, groupName *string) (*StandingsResponse, error)
 	RefreshStandings(ctx context.Context, tournamentID uuid.UUID) error
+	HandleMatchCompleted(ctx context.Context, matchID uuid.UUID) error
 
 	// Tournament match operations
 	GetTournamentMatches(ctx context.Context, tournamentID uuid.UUID, roundNumber *int, groupName *string) (*TournamentMatchesResponse, error)
 	ScheduleMatch(ctx context.Context, tournamentID uuid.UUID, matchID uuid.UUID, roundNumber int, roundName, groupName *string) error
+
+	// Knockout operations
+	GetBracket(ctx context.Context, tournamentID uuid.UUID) (*Bracket, error)
+	ResolveKnockoutMatch(ctx context.Context, tournamentID, matchID uuid.UUID, req ResolveKnockoutRequest, userID uuid.UUID) error
 }
@@ -78,6 +78,7 @@ type TournamentStanding struct {
 	ID           uuid.UUID `json:"id" db:"id"`
 	TournamentID uuid.UUID `json:"tournament_id" db:"tournament_id"`
 	TeamID       uuid.UUID `json:"team_id" db:"team_id"`
+	GroupName    *string   `json:"group_name,omitempty" db:"group_name"`
 
 	// Standings data
 	Position         int `json:"position" db:"position"`
@@ -121,9 +122,90 @@ type TournamentMatch struct {
 	// Group info (for round robin/league)
 	GroupName *string `json:"group_name,omitempty" db:"group_name"`
 
+	// Knockout outcome
+	WinnerTeamID *uuid.UUID `json:"winner_team_id,omitempty" db:"winner_team_id"`
+	Resolution   *string    `json:"resolution,omitempty" db:"resolution"` // result, super_over, higher_seed, coin_toss
+
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 }
 
+// KnockoutResult is the outcome of a completed knockout match as recorded on
+// the match itself
+type KnockoutResult struct {
+	MatchID      uuid.UUID
+	Status       string
+	TeamAID      *uuid.UUID
+	TeamBID      *uuid.UUID
+	WinnerTeamID *uuid.UUID
+	ResultType   string
+
+	// SuperOverWinnerID is set when the deciding super over produced a winner
+	SuperOverWinnerID *uuid.UUID
+}
+
+// Bracket is the knockout tree of a tournament, ready for rendering
+type Bracket struct {
+	TournamentID uuid.UUID      `json:"tournament_id"`
+	Rounds       []BracketRound `json:"rounds"`
+}
+
+// BracketRound is one round of a knockout bracket
+type BracketRound struct {
+	RoundNumber int            `json:"round_number"`
+	RoundName   string         `json:"round_name"`
+	Matches     []BracketMatch `json:"matches"`
+}
+
+// BracketMatch is a single bracket node with both slots and its result
+type BracketMatch struct {
+	TournamentMatchID uuid.UUID  `json:"tournament_match_id"`
+	MatchID           uuid.UUID  `json:"match_id"`
+	RoundNumber       int        `json:"round_number"`
+	RoundName         string     `json:"round_name"`
+	BracketPosition   int        `json:"bracket_position"`
+	MatchNumber       *int       `json:"match_number,omitempty"`
+	NextMatchID       *uuid.UUID `json:"next_match_id,omitempty"`
+	Status            string     `json:"status"`
+	MatchDate         time.Time  `json:"match_date"`
+	MatchTime         string     `json:"match_time"`
+
+	TeamA BracketSlot `json:"team_a"`
+	TeamB BracketSlot `json:"team_b"`
+
+	WinnerTeamID *uuid.UUID `json:"winner_team_id,omitempty"`
+	ResultType   *string    `json:"result_type,omitempty"`
+	WinMargin    *string    `json:"win_margin,omitempty"`
+	Resolution   *string    `json:"resolution,omitempty"`
+}
+
+// BracketSlot is one side of a bracket match. Undecided slots carry a
+// placeholder naming the match whose winner will fill them.
+type BracketSlot struct {
+	TeamID      *uuid.UUID `json:"team_id,omitempty"`
+	TeamName    *string    `json:"team_name,omitempty"`
+	Placeholder *string    `json:"placeholder,omitempty"` // "TBD", "Winner of Quarter Final 2"
+}
+
+// Fixture is a generated tournament match waiting to be created together with
+// its tournament link. Knockout fixtures leave a team nil until the winner of
+// an earlier round is known.
+type Fixture struct {
+	Link TournamentMatch
+
+	Title       string
+	TeamAID     *uuid.UUID
+	TeamBID     *uuid.UUID
+	MatchDate   time.Time
+	MatchTime   string
+	MatchFormat string
+	TotalOvers  int
+	BallType    string
+	VenueName   string
+	VenueCity   string
+	GroundID    *uuid.UUID
+	CreatedBy   uuid.UUID
+}
+
 // DTOs for API
 
 type CreateTournamentRequest struct {
@@ -190,6 +272,11 @@ type StandingsResponse struct {
 	Total     int                  `json:"total"`
 }
 
+type ResolveKnockoutRequest struct {
+	WinnerTeamID uuid.UUID `json:"winner_team_id" binding:"required"`
+	Method       string    `json:"method" binding:"required"` // super_over, higher_seed, coin_toss
+}
+
 type TournamentMatchesResponse struct {
 	Matches []TournamentMatch `json:"matches"`
 	Total   int               `json:"total"`
@@ -0,0 +1,252 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cricketapp/backend/internal/tournament/domain"
+	"github.com/google/uuid"
+)
+
+// retitleMatch rebuilds a generated match title from the teams now playing it
+const retitleMatch = `
+	UPDATE matches m
+	SET title = COALESCE(tm.round_name, 'Match') || ': ' ||
+	            COALESCE((SELECT name FROM teams WHERE id = m.team_a_id), 'TBD') || ' vs ' ||
+	            COALESCE((SELECT name FROM teams WHERE id = m.team_b_id), 'TBD')
+	FROM tournament_matches tm
+	WHERE tm.match_id = m.id AND m.id = $1
+`
+
+// Knockout operations
+
+func (r *tournamentRepository) GetKnockoutResult(ctx context.Context, matchID uuid.UUID) (*domain.KnockoutResult, error) {
+	query := `
+		SELECT id, status, match_format, team_a_id, team_b_id,
+		       result->>'winner_team_id', COALESCE(result->>'result_type', '')
+		FROM matches
+		WHERE id = $1
+	`
+
+	result := &domain.KnockoutResult{}
+	var format string
+	var winnerID *string
+	err := r.db.QueryRowContext(ctx, query, matchID).Scan(
+		&result.MatchID, &result.Status, &format, &result.TeamAID, &result.TeamBID,
+		&winnerID, &result.ResultType,
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("match not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if winnerID != nil {
+		if id, err := uuid.Parse(*winnerID); err == nil {
+			result.WinnerTeamID = &id
+		}
+	}
+
+	if format != "Test" {
+		result.SuperOverWinnerID, err = r.superOverWinner(ctx, matchID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// superOverWinner compares the last completed pair of super over innings
+// (innings 3 onwards of a limited-overs match). It returns nil when no super
+// over was scored or the last one was tied as well.
+func (r *tournamentRepository) superOverWinner(ctx context.Context, matchID uuid.UUID) (*uuid.UUID, error) {
+	query := `
+		SELECT innings, batting_team_id, SUM(runs_off_bat + extras)
+		FROM match_deliveries
+		WHERE match_id = $1 AND innings > 2
+		GROUP BY innings, batting_team_id
+		ORDER BY innings ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []inningsTotal
+	for rows.Next() {
+		var in inningsTotal
+		if err := rows.Scan(&in.innings, &in.teamID, &in.runs); err != nil {
+			return nil, err
+		}
+		totals = append(totals, in)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(totals)%2 == 1 {
+		totals = totals[:len(totals)-1]
+	}
+	if len(totals) < 2 {
+		return nil, nil
+	}
+
+	first, second := totals[len(totals)-2], totals[len(totals)-1]
+	if first.teamID == second.teamID || first.runs == second.runs {
+		return nil, nil
+	}
+	if first.runs > second.runs {
+		return &first.teamID, nil
+	}
+	return &second.teamID, nil
+}
+
+// AdvanceKnockoutWinner records the winner of a knockout match and places it
+// in its slot of the next match: odd bracket positions feed team A, even
+// positions feed team B.
+func (r *tournamentRepository) AdvanceKnockoutWinner(ctx context.Context, tournamentMatch *domain.TournamentMatch, winnerTeamID uuid.UUID, resolution string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE tournament_matches
+		SET winner_team_id = $1, resolution = $2
+		WHERE id = $3
+	`, winnerTeamID, resolution, tournamentMatch.ID)
+	if err != nil {
+		return fmt.Errorf("failed to record winner: %w", err)
+	}
+
+	if tournamentMatch.NextMatchID != nil && tournamentMatch.BracketPosition != nil {
+		var nextMatchID uuid.UUID
+		var nextStatus string
+		err := tx.QueryRowContext(ctx, `
+			SELECT m.id, m.status
+			FROM tournament_matches tm
+			JOIN matches m ON m.id = tm.match_id
+			WHERE tm.id = $1
+			FOR UPDATE OF m
+		`, tournamentMatch.NextMatchID).Scan(&nextMatchID, &nextStatus)
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("next match not found")
+		}
+		if err != nil {
+			return err
+		}
+
+		if nextStatus == "live" || nextStatus == "completed" {
+			return fmt.Errorf("next match has already started")
+		}
+
+		slot := "team_a_id"
+		if *tournamentMatch.BracketPosition%2 == 0 {
+			slot = "team_b_id"
+		}
+
+		_, err = tx.ExecContext(ctx,
+			fmt.Sprintf(`UPDATE matches SET %s = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`, slot),
+			winnerTeamID, nextMatchID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to advance winner: %w", err)
+		}
+
+		// Replace the TBD placeholder in the generated title
+		_, err = tx.ExecContext(ctx, retitleMatch, nextMatchID)
+		if err != nil {
+			return fmt.Errorf("failed to update match title: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *tournamentRepository) CountOpenGroupMatches(ctx context.Context, tournamentID uuid.UUID) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM tournament_matches tm
+		JOIN matches m ON m.id = tm.match_id
+		WHERE tm.tournament_id = $1 AND tm.bracket_position IS NULL
+		  AND m.status IN ('upcoming', 'live')
+	`, tournamentID).Scan(&count)
+	return count, err
+}
+
+// SeedKnockoutMatch puts the two qualifiers into an empty knockout match.
+// Matches that already have a team or have started are left alone.
+func (r *tournamentRepository) SeedKnockoutMatch(ctx context.Context, matchID, teamAID, teamBID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE matches
+		SET team_a_id = $1, team_b_id = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3 AND team_a_id IS NULL AND team_b_id IS NULL AND status = 'upcoming'
+	`, teamAID, teamBID, matchID)
+	if err != nil {
+		return fmt.Errorf("failed to seed knockout match: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, retitleMatch, matchID); err != nil {
+		return fmt.Errorf("failed to update match title: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *tournamentRepository) GetBracketMatches(ctx context.Context, tournamentID uuid.UUID) ([]domain.BracketMatch, error) {
+	query := `
+		SELECT tm.id, tm.match_id, tm.round_number, COALESCE(tm.round_name, ''),
+		       tm.bracket_position, tm.match_number, tm.next_match_id,
+		       tm.winner_team_id, tm.resolution,
+		       m.status, m.match_date, m.match_time,
+		       m.team_a_id, ta.name, m.team_b_id, tb.name,
+		       m.result->>'result_type', m.result->>'win_margin'
+		FROM tournament_matches tm
+		JOIN matches m ON m.id = tm.match_id
+		LEFT JOIN teams ta ON ta.id = m.team_a_id
+		LEFT JOIN teams tb ON tb.id = m.team_b_id
+		WHERE tm.tournament_id = $1 AND tm.bracket_position IS NOT NULL
+		ORDER BY tm.round_number ASC, tm.bracket_position ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matches []domain.BracketMatch
+	for rows.Next() {
+		var bm domain.BracketMatch
+		err := rows.Scan(
+			&bm.TournamentMatchID, &bm.MatchID, &bm.RoundNumber, &bm.RoundName,
+			&bm.BracketPosition, &bm.MatchNumber, &bm.NextMatchID,
+			&bm.WinnerTeamID, &bm.Resolution,
+			&bm.Status, &bm.MatchDate, &bm.MatchTime,
+			&bm.TeamA.TeamID, &bm.TeamA.TeamName, &bm.TeamB.TeamID, &bm.TeamB.TeamName,
+			&bm.ResultType, &bm.WinMargin,
+		)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, bm)
+	}
+
+	return matches, rows.Err()
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cricketapp/backend/internal/tournament/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// CreateFixtures creates the matches of a generated fixture list and links them
+// to the tournament in a single transaction
+func (r *tournamentRepository) CreateFixtures(ctx context.Context, fixtures []domain.Fixture) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	matchQuery := `
+		INSERT INTO matches (
+			id, title, match_type, match_format, team_a_id, team_b_id,
+			match_date, match_time, ground_id, venue_name, venue_city,
+			total_overs, ball_type, status, created_by, created_at, updated_at
+		)
+		VALUES ($1, $2, 'tournament', $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, 'upcoming', $13, $14, $14)
+	`
+
+	linkQuery := `
+		INSERT INTO tournament_matches (
+			id, tournament_id, match_id, round_number, match_number, round_name,
+			bracket_position, group_name, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	now := time.Now()
+	for _, f := range fixtures {
+		_, err := tx.ExecContext(ctx, matchQuery,
+			f.Link.MatchID, f.Title, f.MatchFormat, f.TeamAID, f.TeamBID,
+			f.MatchDate, f.MatchTime, f.GroundID, f.VenueName, f.VenueCity,
+			f.TotalOvers, f.BallType, f.CreatedBy, now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create match: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, linkQuery,
+			f.Link.ID, f.Link.TournamentID, f.Link.MatchID, f.Link.RoundNumber,
+			f.Link.MatchNumber, f.Link.RoundName, f.Link.BracketPosition,
+			f.Link.GroupName, now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to link match to tournament: %w", err)
+		}
+	}
+
+	// Bracket links can only be set once every round has been inserted
+	for _, f := range fixtures {
+		if f.Link.NextMatchID == nil {
+			continue
+		}
+		_, err := tx.ExecContext(ctx,
+			`UPDATE tournament_matches SET next_match_id = $1 WHERE id = $2`,
+			f.Link.NextMatchID, f.Link.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to link bracket: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Team lookups
+
+func (r *tournamentRepository) GetTeamNames(ctx context.Context, teamIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	ids := make([]string, len(teamIDs))
+	for i, id := range teamIDs {
+		ids[i] = id.String()
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT id, name FROM teams WHERE id = ANY($1::uuid[])`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := make(map[uuid.UUID]string, len(teamIDs))
+	for rows.Next() {
+		var id uuid.UUID
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		names[id] = name
+	}
+
+	return names, rows.Err()
+}
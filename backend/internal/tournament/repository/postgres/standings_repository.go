@@ -0,0 +1,462 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cricketapp/backend/internal/tournament/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// allOutWickets ends an innings scored before innings recorded their own
+// wicket limit
+const allOutWickets = 10
+
+// standingsMatch is a league-stage match considered for the standings
+type standingsMatch struct {
+	id         uuid.UUID
+	teamAID    uuid.UUID
+	teamBID    uuid.UUID
+	format     string
+	totalOvers int
+	status     string
+	winnerID   *uuid.UUID
+	resultType string
+	groupName  *string
+}
+
+// inningsTotal is one innings of a match, aggregated from its deliveries
+type inningsTotal struct {
+	innings int
+	teamID  uuid.UUID
+	runs    int
+	wickets int
+	balls   int
+	// Limits recorded on the innings, revised overs included; nil for innings
+	// scored before innings were recorded
+	maxWickets *int
+	maxOvers   *int
+}
+
+// teamRecord accumulates a team's standing while results are replayed
+type teamRecord struct {
+	standing    domain.TournamentStanding
+	ballsFaced  int
+	ballsBowled int
+	ties        int
+	noResults   int
+	bonusPoints int
+	highest     *int
+	lowest      *int
+}
+
+// RecalculateStandings rebuilds the standings of a tournament from every
+// completed league-stage match. Knockout matches (those with a bracket
+// position) do not contribute.
+func (r *tournamentRepository) RecalculateStandings(ctx context.Context, tournamentID uuid.UUID) error {
+	tournament, err := r.GetTournamentByID(ctx, tournamentID)
+	if err != nil {
+		return err
+	}
+	rules := tournament.PointsRules()
+
+	records := make(map[uuid.UUID]*teamRecord)
+	ensure := func(teamID uuid.UUID) *teamRecord {
+		rec, ok := records[teamID]
+		if !ok {
+			rec = &teamRecord{standing: domain.TournamentStanding{
+				ID:           uuid.New(),
+				TournamentID: tournamentID,
+				TeamID:       teamID,
+			}}
+			records[teamID] = rec
+		}
+		return rec
+	}
+
+	// Every approved team appears in the table, even before it has played
+	approved := "approved"
+	registrations, err := r.ListRegistrations(ctx, tournamentID, &approved)
+	if err != nil {
+		return err
+	}
+	for _, reg := range registrations {
+		ensure(reg.TeamID)
+	}
+
+	matches, err := r.listStandingsMatches(ctx, tournamentID)
+	if err != nil {
+		return err
+	}
+
+	innings, err := r.listInningsTotals(ctx, tournamentID)
+	if err != nil {
+		return err
+	}
+
+	// headToHead[a][b] holds the points a earned in matches against b
+	headToHead := make(map[uuid.UUID]map[uuid.UUID]int)
+	award := func(teamID, opponentID uuid.UUID, points int) {
+		ensure(teamID).standing.Points += points
+		if headToHead[teamID] == nil {
+			headToHead[teamID] = make(map[uuid.UUID]int)
+		}
+		headToHead[teamID][opponentID] += points
+	}
+
+	for _, m := range matches {
+		teamA, teamB := ensure(m.teamAID), ensure(m.teamBID)
+		if m.groupName != nil {
+			if teamA.standing.GroupName == nil {
+				teamA.standing.GroupName = m.groupName
+			}
+			if teamB.standing.GroupName == nil {
+				teamB.standing.GroupName = m.groupName
+			}
+		}
+
+		if m.status != "completed" {
+			continue
+		}
+
+		teamA.standing.MatchesPlayed++
+		teamB.standing.MatchesPlayed++
+
+		switch outcome(m) {
+		case "no_result":
+			// No-result matches are left out of net run rate entirely
+			teamA.standing.MatchesAbandoned++
+			teamB.standing.MatchesAbandoned++
+			teamA.noResults++
+			teamB.noResults++
+			award(m.teamAID, m.teamBID, rules.NoResult)
+			award(m.teamBID, m.teamAID, rules.NoResult)
+			continue
+		case "tie":
+			teamA.standing.MatchesDrawn++
+			teamB.standing.MatchesDrawn++
+			teamA.ties++
+			teamB.ties++
+			award(m.teamAID, m.teamBID, rules.Tie)
+			award(m.teamBID, m.teamAID, rules.Tie)
+		case "draw":
+			teamA.standing.MatchesDrawn++
+			teamB.standing.MatchesDrawn++
+			award(m.teamAID, m.teamBID, rules.Draw)
+			award(m.teamBID, m.teamAID, rules.Draw)
+		default:
+			winnerID, loserID := m.teamAID, m.teamBID
+			if *m.winnerID == m.teamBID {
+				winnerID, loserID = m.teamBID, m.teamAID
+			}
+			winner, loser := records[winnerID], records[loserID]
+			winner.standing.MatchesWon++
+			loser.standing.MatchesLost++
+			award(winnerID, loserID, rules.Win)
+			award(loserID, winnerID, rules.Loss)
+
+			if rules.BonusRunRateFactor > 0 && earnsBonusPoint(m, innings[m.id], winnerID, loserID, rules.BonusRunRateFactor) {
+				winner.bonusPoints++
+				award(winnerID, loserID, rules.Bonus)
+			}
+		}
+
+		for _, in := range countedInnings(m, innings[m.id]) {
+			var batting, bowling *teamRecord
+			switch in.teamID {
+			case m.teamAID:
+				batting, bowling = teamA, teamB
+			case m.teamBID:
+				batting, bowling = teamB, teamA
+			default:
+				continue
+			}
+
+			balls := effectiveBalls(m, in)
+			batting.standing.RunsScored += in.runs
+			batting.standing.WicketsLost += in.wickets
+			batting.ballsFaced += balls
+			bowling.standing.RunsConceded += in.runs
+			bowling.standing.WicketsTaken += in.wickets
+			bowling.ballsBowled += balls
+
+			runs := in.runs
+			if batting.highest == nil || runs > *batting.highest {
+				batting.highest = &runs
+			}
+			if batting.lowest == nil || runs < *batting.lowest {
+				batting.lowest = &runs
+			}
+		}
+	}
+
+	standings := make([]*domain.TournamentStanding, 0, len(records))
+	now := time.Now()
+	for _, rec := range records {
+		rec.standing.NetRunRate = netRunRate(rec)
+		rec.standing.Stats = map[string]interface{}{
+			"ties":         rec.ties,
+			"no_results":   rec.noResults,
+			"bonus_points": rec.bonusPoints,
+			"balls_faced":  rec.ballsFaced,
+			"balls_bowled": rec.ballsBowled,
+		}
+		if rec.highest != nil {
+			rec.standing.Stats["highest_score"] = *rec.highest
+			rec.standing.Stats["lowest_score"] = *rec.lowest
+		}
+		rec.standing.UpdatedAt = now
+		standings = append(standings, &rec.standing)
+	}
+
+	assignPositions(standings, rules.TieBreakers, headToHead)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	teamIDs := make([]string, 0, len(standings))
+	for _, standing := range standings {
+		if err := upsertStanding(ctx, tx, standing); err != nil {
+			return fmt.Errorf("failed to save standing: %w", err)
+		}
+		teamIDs = append(teamIDs, standing.TeamID.String())
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM tournament_standings
+		WHERE tournament_id = $1 AND NOT (team_id = ANY($2::uuid[]))
+	`, tournamentID, pq.Array(teamIDs))
+	if err != nil {
+		return fmt.Errorf("failed to remove stale standings: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *tournamentRepository) listStandingsMatches(ctx context.Context, tournamentID uuid.UUID) ([]standingsMatch, error) {
+	query := `
+		SELECT m.id, m.team_a_id, m.team_b_id, m.match_format, m.total_overs, m.status,
+		       m.result->>'winner_team_id', COALESCE(m.result->>'result_type', ''),
+		       tm.group_name
+		FROM tournament_matches tm
+		JOIN matches m ON m.id = tm.match_id
+		WHERE tm.tournament_id = $1 AND tm.bracket_position IS NULL
+		ORDER BY m.match_date ASC, m.match_time ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matches []standingsMatch
+	for rows.Next() {
+		var m standingsMatch
+		var winnerID *string
+		if err := rows.Scan(
+			&m.id, &m.teamAID, &m.teamBID, &m.format, &m.totalOvers, &m.status,
+			&winnerID, &m.resultType, &m.groupName,
+		); err != nil {
+			return nil, err
+		}
+		if winnerID != nil {
+			if id, err := uuid.Parse(*winnerID); err == nil {
+				m.winnerID = &id
+			}
+		}
+		matches = append(matches, m)
+	}
+
+	return matches, rows.Err()
+}
+
+func (r *tournamentRepository) listInningsTotals(ctx context.Context, tournamentID uuid.UUID) (map[uuid.UUID][]inningsTotal, error) {
+	query := `
+		SELECT d.match_id, d.innings, d.batting_team_id,
+		       SUM(d.runs_off_bat + d.extras),
+		       COUNT(*) FILTER (WHERE d.is_wicket AND d.wicket_type IS DISTINCT FROM 'retired_hurt'),
+		       COUNT(*) FILTER (WHERE d.extra_type IS NULL OR d.extra_type NOT IN ('wide', 'no_ball')),
+		       mi.max_wickets, COALESCE(mi.revised_overs, mi.max_overs)
+		FROM match_deliveries d
+		JOIN tournament_matches tm ON tm.match_id = d.match_id
+		LEFT JOIN match_innings mi ON mi.match_id = d.match_id AND mi.innings_number = d.innings
+		WHERE tm.tournament_id = $1
+		GROUP BY d.match_id, d.innings, d.batting_team_id, mi.max_wickets, mi.max_overs, mi.revised_overs
+		ORDER BY d.match_id, d.innings
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := make(map[uuid.UUID][]inningsTotal)
+	for rows.Next() {
+		var matchID uuid.UUID
+		var in inningsTotal
+		if err := rows.Scan(&matchID, &in.innings, &in.teamID, &in.runs, &in.wickets, &in.balls, &in.maxWickets, &in.maxOvers); err != nil {
+			return nil, err
+		}
+		totals[matchID] = append(totals[matchID], in)
+	}
+
+	return totals, rows.Err()
+}
+
+// outcome classifies a completed match as win, tie, draw or no_result
+func outcome(m standingsMatch) string {
+	switch m.resultType {
+	case "tie":
+		return "tie"
+	case "draw":
+		return "draw"
+	case "no-result", "no_result", "abandoned":
+		return "no_result"
+	}
+	if m.winnerID == nil || (*m.winnerID != m.teamAID && *m.winnerID != m.teamBID) {
+		return "no_result"
+	}
+	return "win"
+}
+
+func isLimitedOvers(m standingsMatch) bool {
+	return m.format != "Test"
+}
+
+// countedInnings drops super overs from limited-overs matches; they decide
+// the winner but do not count towards runs or net run rate
+func countedInnings(m standingsMatch, innings []inningsTotal) []inningsTotal {
+	if !isLimitedOvers(m) {
+		return innings
+	}
+	var counted []inningsTotal
+	for _, in := range innings {
+		if in.innings <= 2 {
+			counted = append(counted, in)
+		}
+	}
+	return counted
+}
+
+// effectiveBalls is the number of balls an innings counts for in net run
+// rate. A side bowled out is treated as having faced its full quota of overs,
+// as revised for the innings. Innings without recorded limits fall back to
+// the match's overs and ten wickets.
+func effectiveBalls(m standingsMatch, in inningsTotal) int {
+	if !isLimitedOvers(m) {
+		return in.balls
+	}
+
+	maxWickets, overs := allOutWickets, m.totalOvers
+	if in.maxWickets != nil {
+		maxWickets = *in.maxWickets
+		overs = 0
+		if in.maxOvers != nil {
+			overs = *in.maxOvers
+		}
+	}
+
+	if in.wickets >= maxWickets && overs > 0 {
+		return overs * 6
+	}
+	return in.balls
+}
+
+// earnsBonusPoint reports whether the winner's run rate in the match was at
+// least factor times the loser's
+func earnsBonusPoint(m standingsMatch, innings []inningsTotal, winnerID, loserID uuid.UUID, factor float64) bool {
+	var winnerRuns, winnerBalls, loserRuns, loserBalls int
+	for _, in := range countedInnings(m, innings) {
+		switch in.teamID {
+		case winnerID:
+			winnerRuns += in.runs
+			winnerBalls += effectiveBalls(m, in)
+		case loserID:
+			loserRuns += in.runs
+			loserBalls += effectiveBalls(m, in)
+		}
+	}
+	if winnerBalls == 0 || loserBalls == 0 {
+		return false
+	}
+
+	winnerRate := float64(winnerRuns) / float64(winnerBalls)
+	loserRate := float64(loserRuns) / float64(loserBalls)
+	return winnerRate >= loserRate*factor
+}
+
+func netRunRate(rec *teamRecord) float64 {
+	var nrr float64
+	if rec.ballsFaced > 0 {
+		nrr += float64(rec.standing.RunsScored) * 6 / float64(rec.ballsFaced)
+	}
+	if rec.ballsBowled > 0 {
+		nrr -= float64(rec.standing.RunsConceded) * 6 / float64(rec.ballsBowled)
+	}
+	return math.Round(nrr*1000) / 1000
+}
+
+// assignPositions ranks standings within each group by points, then by the
+// configured tie breakers, falling back to team ID so the order is stable
+func assignPositions(standings []*domain.TournamentStanding, tieBreakers []string, headToHead map[uuid.UUID]map[uuid.UUID]int) {
+	groupOf := func(s *domain.TournamentStanding) string {
+		if s.GroupName == nil {
+			return ""
+		}
+		return *s.GroupName
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if groupOf(a) != groupOf(b) {
+			return groupOf(a) < groupOf(b)
+		}
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		for _, tb := range tieBreakers {
+			switch tb {
+			case domain.TieBreakerWins:
+				if a.MatchesWon != b.MatchesWon {
+					return a.MatchesWon > b.MatchesWon
+				}
+			case domain.TieBreakerNetRunRate:
+				if a.NetRunRate != b.NetRunRate {
+					return a.NetRunRate > b.NetRunRate
+				}
+			case domain.TieBreakerHeadToHead:
+				aPoints, bPoints := headToHead[a.TeamID][b.TeamID], headToHead[b.TeamID][a.TeamID]
+				if aPoints != bPoints {
+					return aPoints > bPoints
+				}
+			case domain.TieBreakerRunsScored:
+				if a.RunsScored != b.RunsScored {
+					return a.RunsScored > b.RunsScored
+				}
+			case domain.TieBreakerWicketsTaken:
+				if a.WicketsTaken != b.WicketsTaken {
+					return a.WicketsTaken > b.WicketsTaken
+				}
+			}
+		}
+		return a.TeamID.String() < b.TeamID.String()
+	})
+
+	position := 0
+	for i, s := range standings {
+		if i == 0 || groupOf(s) != groupOf(standings[i-1]) {
+			position = 0
+		}
+		position++
+		s.Position = position
+	}
+}
@@ -231,16 +231,23 @@ func (r *tournamentRepository) RegisterTeam(ctx context.Context, registration *d
 }
 
 func (r *tournamentRepository) GetRegistration(ctx context.Context, tournamentID, teamID uuid.UUID) (*domain.TournamentRegistration, error) {
+	return r.getRegistration(ctx, "tournament_id = $1 AND team_id = $2", tournamentID, teamID)
+}
+
+func (r *tournamentRepository) GetRegistrationByID(ctx context.Context, registrationID uuid.UUID) (*domain.TournamentRegistration, error) {
+	return r.getRegistration(ctx, "id = $1", registrationID)
+}
+
+func (r *tournamentRepository) getRegistration(ctx context.Context, where string, args ...interface{}) (*domain.TournamentRegistration, error) {
 	query := `
 		SELECT id, tournament_id, team_id, registration_date, status, payment_status,
 		       captain_id, squad_size, approved_by, approved_at, rejection_reason,
 		       created_at, updated_at
 		FROM tournament_registrations
-		WHERE tournament_id = $1 AND team_id = $2
-	`
+		WHERE ` + where
 
 	var reg domain.TournamentRegistration
-	err := r.db.QueryRowContext(ctx, query, tournamentID, teamID).Scan(
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(
 		&reg.ID, &reg.TournamentID, &reg.TeamID, &reg.RegistrationDate,
 		&reg.Status, &reg.PaymentStatus, &reg.CaptainID, &reg.SquadSize,
 		&reg.ApprovedBy, &reg.ApprovedAt, &reg.RejectionReason,
@@ -323,9 +330,35 @@ func (r *tournamentRepository) GetRegistrationCount(ctx context.Context, tournam
 	return count, err
 }
 
+// IsTeamManager reports whether the user owns, manages or captains the team
+func (r *tournamentRepository) IsTeamManager(ctx context.Context, teamID, userID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM team_roles
+			WHERE team_id = $1 AND user_id = $2 AND role IN ('owner', 'manager', 'captain')
+		)
+	`
+
+	var manager bool
+	if err := r.db.QueryRowContext(ctx, query, teamID, userID).Scan(&manager); err != nil {
+		return false, err
+	}
+
+	return manager, nil
+}
+
 // Standings operations
 
 func (r *tournamentRepository) CreateOrUpdateStanding(ctx context.Context, standing *domain.TournamentStanding) error {
+	return upsertStanding(ctx, r.db, standing)
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func upsertStanding(ctx context.Context, db execer, standing *domain.TournamentStanding) error {
 	statsJSON, err := json.Marshal(standing.Stats)
 	if err != nil {
 		return fmt.Errorf("failed to marshal stats: %w", err)
@@ -333,12 +366,13 @@ func (r *tournamentRepository) CreateOrUpdateStanding(ctx context.Context, stand
 
 	query := `
 		INSERT INTO tournament_standings (
-			id, tournament_id, team_id, position, matches_played, matches_won,
+			id, tournament_id, team_id, group_name, position, matches_played, matches_won,
 			matches_lost, matches_drawn, matches_abandoned, points, net_run_rate,
 			runs_scored, runs_conceded, wickets_taken, wickets_lost, stats, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 		ON CONFLICT (tournament_id, team_id)
 		DO UPDATE SET
+			group_name = EXCLUDED.group_name,
 			position = EXCLUDED.position,
 			matches_played = EXCLUDED.matches_played,
 			matches_won = EXCLUDED.matches_won,
@@ -355,8 +389,8 @@ func (r *tournamentRepository) CreateOrUpdateStanding(ctx context.Context, stand
 			updated_at = EXCLUDED.updated_at
 	`
 
-	_, err = r.db.ExecContext(ctx, query,
-		standing.ID, standing.TournamentID, standing.TeamID, standing.Position,
+	_, err = db.ExecContext(ctx, query,
+		standing.ID, standing.TournamentID, standing.TeamID, standing.GroupName, standing.Position,
 		standing.MatchesPlayed, standing.MatchesWon, standing.MatchesLost,
 		standing.MatchesDrawn, standing.MatchesAbandoned, standing.Points,
 		standing.NetRunRate, standing.RunsScored, standing.RunsConceded,
@@ -368,16 +402,17 @@ func (r *tournamentRepository) CreateOrUpdateStanding(ctx context.Context, stand
 
 func (r *tournamentRepository) GetStandings(ctx context.Context, tournamentID uuid.UUID, groupName *string) ([]domain.TournamentStanding, error) {
 	query := `
-		SELECT s.id, s.tournament_id, s.team_id, s.position, s.matches_played,
+		SELECT s.id, s.tournament_id, s.team_id, s.group_name, s.position, s.matches_played,
 		       s.matches_won, s.matches_lost, s.matches_drawn, s.matches_abandoned,
 		       s.points, s.net_run_rate, s.runs_scored, s.runs_conceded,
 		       s.wickets_taken, s.wickets_lost, s.stats, s.updated_at
 		FROM tournament_standings s
 		WHERE s.tournament_id = $1
-		ORDER BY s.position ASC, s.points DESC, s.net_run_rate DESC
+		  AND ($2::text IS NULL OR s.group_name = $2)
+		ORDER BY s.group_name ASC NULLS FIRST, s.position ASC, s.points DESC, s.net_run_rate DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, tournamentID)
+	rows, err := r.db.QueryContext(ctx, query, tournamentID, groupName)
 	if err != nil {
 		return nil, err
 	}
@@ -389,7 +424,7 @@ func (r *tournamentRepository) GetStandings(ctx context.Context, tournamentID uu
 		var statsJSON []byte
 
 		err := rows.Scan(
-			&s.ID, &s.TournamentID, &s.TeamID, &s.Position, &s.MatchesPlayed,
+			&s.ID, &s.TournamentID, &s.TeamID, &s.GroupName, &s.Position, &s.MatchesPlayed,
 			&s.MatchesWon, &s.MatchesLost, &s.MatchesDrawn, &s.MatchesAbandoned,
 			&s.Points, &s.NetRunRate, &s.RunsScored, &s.RunsConceded,
 			&s.WicketsTaken, &s.WicketsLost, &statsJSON, &s.UpdatedAt,
@@ -412,7 +447,7 @@ func (r *tournamentRepository) GetStandings(ctx context.Context, tournamentID uu
 
 func (r *tournamentRepository) GetTeamStanding(ctx context.Context, tournamentID, teamID uuid.UUID) (*domain.TournamentStanding, error) {
 	query := `
-		SELECT id, tournament_id, team_id, position, matches_played,
+		SELECT id, tournament_id, team_id, group_name, position, matches_played,
 		       matches_won, matches_lost, matches_drawn, matches_abandoned,
 		       points, net_run_rate, runs_scored, runs_conceded,
 		       wickets_taken, wickets_lost, stats, updated_at
@@ -424,7 +459,7 @@ func (r *tournamentRepository) GetTeamStanding(ctx context.Context, tournamentID
 	var statsJSON []byte
 
 	err := r.db.QueryRowContext(ctx, query, tournamentID, teamID).Scan(
-		&s.ID, &s.TournamentID, &s.TeamID, &s.Position, &s.MatchesPlayed,
+		&s.ID, &s.TournamentID, &s.TeamID, &s.GroupName, &s.Position, &s.MatchesPlayed,
 		&s.MatchesWon, &s.MatchesLost, &s.MatchesDrawn, &s.MatchesAbandoned,
 		&s.Points, &s.NetRunRate, &s.RunsScored, &s.RunsConceded,
 		&s.WicketsTaken, &s.WicketsLost, &statsJSON, &s.UpdatedAt,
@@ -446,12 +481,6 @@ func (r *tournamentRepository) GetTeamStanding(ctx context.Context, tournamentID
 	return &s, nil
 }
 
-func (r *tournamentRepository) RecalculateStandings(ctx context.Context, tournamentID uuid.UUID) error {
-	// This would implement complex logic to recalculate standings from match results
-	// For now, it's a placeholder
-	return nil
-}
-
 // Tournament match operations
 
 func (r *tournamentRepository) LinkMatchToTournament(ctx context.Context, tournamentMatch *domain.TournamentMatch) error {
@@ -475,7 +504,7 @@ func (r *tournamentRepository) LinkMatchToTournament(ctx context.Context, tourna
 func (r *tournamentRepository) GetTournamentMatches(ctx context.Context, tournamentID uuid.UUID, roundNumber *int, groupName *string) ([]domain.TournamentMatch, error) {
 	query := `
 		SELECT id, tournament_id, match_id, round_number, match_number, round_name,
-		       bracket_position, next_match_id, group_name, created_at
+		       bracket_position, next_match_id, group_name, winner_team_id, resolution, created_at
 		FROM tournament_matches
 		WHERE tournament_id = $1
 		  AND ($2::int IS NULL OR round_number = $2)
@@ -495,7 +524,7 @@ func (r *tournamentRepository) GetTournamentMatches(ctx context.Context, tournam
 		err := rows.Scan(
 			&tm.ID, &tm.TournamentID, &tm.MatchID, &tm.RoundNumber,
 			&tm.MatchNumber, &tm.RoundName, &tm.BracketPosition,
-			&tm.NextMatchID, &tm.GroupName, &tm.CreatedAt,
+			&tm.NextMatchID, &tm.GroupName, &tm.WinnerTeamID, &tm.Resolution, &tm.CreatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -509,7 +538,7 @@ func (r *tournamentRepository) GetTournamentMatches(ctx context.Context, tournam
 func (r *tournamentRepository) GetTournamentMatch(ctx context.Context, tournamentID, matchID uuid.UUID) (*domain.TournamentMatch, error) {
 	query := `
 		SELECT id, tournament_id, match_id, round_number, match_number, round_name,
-		       bracket_position, next_match_id, group_name, created_at
+		       bracket_position, next_match_id, group_name, winner_team_id, resolution, created_at
 		FROM tournament_matches
 		WHERE tournament_id = $1 AND match_id = $2
 	`
@@ -518,7 +547,7 @@ func (r *tournamentRepository) GetTournamentMatch(ctx context.Context, tournamen
 	err := r.db.QueryRowContext(ctx, query, tournamentID, matchID).Scan(
 		&tm.ID, &tm.TournamentID, &tm.MatchID, &tm.RoundNumber,
 		&tm.MatchNumber, &tm.RoundName, &tm.BracketPosition,
-		&tm.NextMatchID, &tm.GroupName, &tm.CreatedAt,
+		&tm.NextMatchID, &tm.GroupName, &tm.WinnerTeamID, &tm.Resolution, &tm.CreatedAt,
 	)
 
 	if err == sql.ErrNoRows {
@@ -531,6 +560,34 @@ func (r *tournamentRepository) GetTournamentMatch(ctx context.Context, tournamen
 	return &tm, nil
 }
 
+// GetTournamentMatchByMatchID returns the tournament link of a match, or nil
+// when the match is not part of any tournament
+func (r *tournamentRepository) GetTournamentMatchByMatchID(ctx context.Context, matchID uuid.UUID) (*domain.TournamentMatch, error) {
+	query := `
+		SELECT id, tournament_id, match_id, round_number, match_number, round_name,
+		       bracket_position, next_match_id, group_name, winner_team_id, resolution, created_at
+		FROM tournament_matches
+		WHERE match_id = $1
+		LIMIT 1
+	`
+
+	var tm domain.TournamentMatch
+	err := r.db.QueryRowContext(ctx, query, matchID).Scan(
+		&tm.ID, &tm.TournamentID, &tm.MatchID, &tm.RoundNumber,
+		&tm.MatchNumber, &tm.RoundName, &tm.BracketPosition,
+		&tm.NextMatchID, &tm.GroupName, &tm.WinnerTeamID, &tm.Resolution, &tm.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &tm, nil
+}
+
 func (r *tournamentRepository) UpdateTournamentMatch(ctx context.Context, tournamentMatchID uuid.UUID, tournamentMatch *domain.TournamentMatch) error {
 	query := `
 		UPDATE tournament_matches
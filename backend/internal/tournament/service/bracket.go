@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cricketapp/backend/internal/tournament/domain"
+	"github.com/google/uuid"
+)
+
+// Knockout operations
+
+func (s *tournamentService) GetBracket(ctx context.Context, tournamentID uuid.UUID) (*domain.Bracket, error) {
+	tournament, err := s.repo.GetTournamentByID(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.repo.GetBracketMatches(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first knockout round of a mixed tournament waits for group qualifiers
+	var seeds []qualifier
+	if tournament.TournamentType == "mixed" && len(matches) > 0 {
+		groups, err := s.groupNames(ctx, tournamentID)
+		if err != nil {
+			return nil, err
+		}
+		seeds = qualifierSeeds(groups, tournament.IntRule(domain.RuleQualifiersPerGroup, 2))
+	}
+
+	// feeders[next][slot] is the match whose winner fills that slot
+	feeders := make(map[uuid.UUID]map[int]domain.BracketMatch)
+	for _, m := range matches {
+		if m.NextMatchID == nil {
+			continue
+		}
+		if feeders[*m.NextMatchID] == nil {
+			feeders[*m.NextMatchID] = make(map[int]domain.BracketMatch)
+		}
+		feeders[*m.NextMatchID][m.BracketPosition%2] = m
+	}
+
+	placeholder := func(m domain.BracketMatch, slot int) *string {
+		if feeder, ok := feeders[m.TournamentMatchID][slot]; ok {
+			return strPtr(fmt.Sprintf("Winner of %s %d", feeder.RoundName, feeder.BracketPosition))
+		}
+		if m.RoundNumber == matches[0].RoundNumber {
+			if a, b, ok := slotQualifiers(seeds, m.BracketPosition); ok {
+				if slot == 1 {
+					return strPtr(a.String())
+				}
+				return strPtr(b.String())
+			}
+		}
+		return strPtr("TBD")
+	}
+
+	bracket := &domain.Bracket{TournamentID: tournamentID, Rounds: []domain.BracketRound{}}
+	for _, m := range matches {
+		// Odd positions feed team A, even positions feed team B
+		if m.TeamA.TeamID == nil {
+			m.TeamA.Placeholder = placeholder(m, 1)
+		}
+		if m.TeamB.TeamID == nil {
+			m.TeamB.Placeholder = placeholder(m, 0)
+		}
+
+		if n := len(bracket.Rounds); n == 0 || bracket.Rounds[n-1].RoundNumber != m.RoundNumber {
+			bracket.Rounds = append(bracket.Rounds, domain.BracketRound{
+				RoundNumber: m.RoundNumber,
+				RoundName:   m.RoundName,
+			})
+		}
+		round := &bracket.Rounds[len(bracket.Rounds)-1]
+		round.Matches = append(round.Matches, m)
+	}
+
+	return bracket, nil
+}
+
+func (s *tournamentService) ResolveKnockoutMatch(ctx context.Context, tournamentID, matchID uuid.UUID, req domain.ResolveKnockoutRequest, userID uuid.UUID) error {
+	tournament, err := s.repo.GetTournamentByID(ctx, tournamentID)
+	if err != nil {
+		return err
+	}
+
+	if tournament.OrganizerID != userID {
+		return fmt.Errorf("unauthorized: only the organizer can resolve knockout matches")
+	}
+
+	if !domain.ValidTieResolution(req.Method) {
+		return fmt.Errorf("invalid resolution method: %s", req.Method)
+	}
+
+	link, err := s.repo.GetTournamentMatch(ctx, tournamentID, matchID)
+	if err != nil {
+		return err
+	}
+	if link.BracketPosition == nil {
+		return fmt.Errorf("only knockout matches can be resolved")
+	}
+
+	result, err := s.repo.GetKnockoutResult(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if result.Status != "completed" {
+		return fmt.Errorf("match has not been completed")
+	}
+	if result.WinnerTeamID != nil || result.ResultType == "normal" {
+		return fmt.Errorf("match already has a winner")
+	}
+	if link.WinnerTeamID != nil {
+		return fmt.Errorf("match has already been resolved")
+	}
+	if !isMatchTeam(result, req.WinnerTeamID) {
+		return fmt.Errorf("winner must be one of the match teams")
+	}
+
+	return s.repo.AdvanceKnockoutWinner(ctx, link, req.WinnerTeamID, req.Method)
+}
+
+// progressKnockout moves the winner of a completed knockout match into the
+// next round. Ties and no-results are decided by the tournament's
+// knockout_tie_resolution rule; when that cannot decide the match (a coin toss,
+// or no super over was scored) it waits for the organizer to resolve it.
+func (s *tournamentService) progressKnockout(ctx context.Context, link *domain.TournamentMatch) error {
+	result, err := s.repo.GetKnockoutResult(ctx, link.MatchID)
+	if err != nil {
+		return err
+	}
+
+	if result.WinnerTeamID != nil && isMatchTeam(result, *result.WinnerTeamID) {
+		return s.repo.AdvanceKnockoutWinner(ctx, link, *result.WinnerTeamID, domain.ResolutionResult)
+	}
+
+	tournament, err := s.repo.GetTournamentByID(ctx, link.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	switch tournament.StringRule(domain.RuleKnockoutTieResolution, domain.ResolutionSuperOver) {
+	case domain.ResolutionSuperOver:
+		if result.SuperOverWinnerID != nil {
+			return s.repo.AdvanceKnockoutWinner(ctx, link, *result.SuperOverWinnerID, domain.ResolutionSuperOver)
+		}
+	case domain.ResolutionHigherSeed:
+		winner, err := s.higherSeed(ctx, tournament, result)
+		if err != nil {
+			return err
+		}
+		if winner != nil {
+			return s.repo.AdvanceKnockoutWinner(ctx, link, *winner, domain.ResolutionHigherSeed)
+		}
+	}
+
+	return nil
+}
+
+// seedQualifiers fills the first knockout round of a mixed tournament from the
+// group standings once every group match has been played or cancelled
+func (s *tournamentService) seedQualifiers(ctx context.Context, tournamentID uuid.UUID) error {
+	tournament, err := s.repo.GetTournamentByID(ctx, tournamentID)
+	if err != nil {
+		return err
+	}
+	if tournament.TournamentType != "mixed" {
+		return nil
+	}
+
+	open, err := s.repo.CountOpenGroupMatches(ctx, tournamentID)
+	if err != nil || open > 0 {
+		return err
+	}
+
+	matches, err := s.repo.GetBracketMatches(ctx, tournamentID)
+	if err != nil || len(matches) == 0 {
+		return err
+	}
+
+	groups, err := s.groupNames(ctx, tournamentID)
+	if err != nil {
+		return err
+	}
+	seeds := qualifierSeeds(groups, tournament.IntRule(domain.RuleQualifiersPerGroup, 2))
+
+	standings, err := s.repo.GetStandings(ctx, tournamentID, nil)
+	if err != nil {
+		return err
+	}
+	placed := make(map[qualifier]uuid.UUID)
+	for _, standing := range standings {
+		if standing.GroupName != nil {
+			placed[qualifier{group: *standing.GroupName, place: standing.Position}] = standing.TeamID
+		}
+	}
+
+	for _, m := range matches {
+		if m.RoundNumber != matches[0].RoundNumber {
+			break
+		}
+		a, b, ok := slotQualifiers(seeds, m.BracketPosition)
+		if !ok {
+			return fmt.Errorf("knockout match %d has no qualifier slots", m.BracketPosition)
+		}
+		teamA, foundA := placed[a]
+		teamB, foundB := placed[b]
+		if !foundA || !foundB {
+			return fmt.Errorf("group standings are missing qualifiers for knockout match %d", m.BracketPosition)
+		}
+		if err := s.repo.SeedKnockoutMatch(ctx, m.MatchID, teamA, teamB); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// groupNames lists the groups of a tournament's group stage in order
+func (s *tournamentService) groupNames(ctx context.Context, tournamentID uuid.UUID) ([]string, error) {
+	links, err := s.repo.GetTournamentMatches(ctx, tournamentID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var groups []string
+	for _, link := range links {
+		if link.GroupName != nil && link.BracketPosition == nil && !seen[*link.GroupName] {
+			seen[*link.GroupName] = true
+			groups = append(groups, *link.GroupName)
+		}
+	}
+	sort.Strings(groups)
+
+	return groups, nil
+}
+
+// slotQualifiers returns the qualifiers that meet in a first round knockout
+// match, placed by seed like knockoutRounds does
+func slotQualifiers(seeds []qualifier, position int) (qualifier, qualifier, bool) {
+	if position < 1 || 2*position > len(seeds) {
+		return qualifier{}, qualifier{}, false
+	}
+	order := bracketOrder(len(seeds))
+	return seeds[order[2*position-2]-1], seeds[order[2*position-1]-1], true
+}
+
+func (q qualifier) String() string {
+	switch q.place {
+	case 1:
+		return fmt.Sprintf("Winner of %s", q.group)
+	case 2:
+		return fmt.Sprintf("Runner-up of %s", q.group)
+	}
+	return fmt.Sprintf("%s position %d", q.group, q.place)
+}
+
+// higherSeed returns whichever team of the match was seeded higher
+func (s *tournamentService) higherSeed(ctx context.Context, tournament *domain.Tournament, result *domain.KnockoutResult) (*uuid.UUID, error) {
+	if result.TeamAID == nil || result.TeamBID == nil {
+		return nil, nil
+	}
+
+	approved := "approved"
+	registrations, err := s.repo.ListRegistrations(ctx, tournament.ID, &approved)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, team := range seedTeams(tournament, registrations) {
+		if team == *result.TeamAID {
+			return result.TeamAID, nil
+		}
+		if team == *result.TeamBID {
+			return result.TeamBID, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func isMatchTeam(result *domain.KnockoutResult, teamID uuid.UUID) bool {
+	return (result.TeamAID != nil && *result.TeamAID == teamID) ||
+		(result.TeamBID != nil && *result.TeamBID == teamID)
+}
@@ -0,0 +1,436 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cricketapp/backend/internal/tournament/domain"
+	"github.com/google/uuid"
+)
+
+// defaultOvers is the innings length used for generated matches of each format
+var defaultOvers = map[string]int{"T10": 10, "T20": 20, "ODI": 50, "Test": 90}
+
+// pairing is a generated match between two teams before it is scheduled.
+// A nil team is a slot waiting for the winner of an earlier knockout match.
+type pairing struct {
+	teamA, teamB *uuid.UUID
+	link         domain.TournamentMatch
+}
+
+// generateFixtures creates the full fixture list of a tournament from its
+// approved registrations. Tournaments that already have matches scheduled by
+// hand are left untouched.
+func (s *tournamentService) generateFixtures(ctx context.Context, tournament *domain.Tournament) error {
+	existing, err := s.repo.GetTournamentMatches(ctx, tournament.ID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return nil
+	}
+
+	approved := "approved"
+	registrations, err := s.repo.ListRegistrations(ctx, tournament.ID, &approved)
+	if err != nil {
+		return err
+	}
+	if len(registrations) < tournament.MinTeams {
+		return fmt.Errorf("at least %d approved teams are required to start the tournament", tournament.MinTeams)
+	}
+
+	teams := seedTeams(tournament, registrations)
+
+	var rounds [][]pairing
+	switch tournament.TournamentType {
+	case "knockout":
+		rounds = knockoutRounds(tournament.ID, teams)
+	case "league":
+		rounds = groupRounds(tournament.ID, teams, tournament.IntRule(domain.RuleGroups, 1), tournament.BoolRule(domain.RuleDoubleRoundRobin, true))
+	case "mixed":
+		groups := fitGroups(tournament.IntRule(domain.RuleGroups, 2), len(teams))
+		rounds = groupRounds(tournament.ID, teams, groups, tournament.BoolRule(domain.RuleDoubleRoundRobin, false))
+
+		knockout, err := qualifierRounds(tournament.ID, groups, tournament.IntRule(domain.RuleQualifiersPerGroup, 2), len(teams), len(rounds)+1)
+		if err != nil {
+			return err
+		}
+		rounds = append(rounds, knockout...)
+	default:
+		rounds = groupRounds(tournament.ID, teams, tournament.IntRule(domain.RuleGroups, 1), tournament.BoolRule(domain.RuleDoubleRoundRobin, false))
+	}
+
+	names, err := s.repo.GetTeamNames(ctx, teams)
+	if err != nil {
+		return err
+	}
+
+	fixtures, err := scheduleFixtures(tournament, rounds, names)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.CreateFixtures(ctx, fixtures)
+}
+
+// seedTeams orders the approved teams by the seeds listed in the rules,
+// followed by the remaining teams in registration order
+func seedTeams(tournament *domain.Tournament, registrations []domain.TournamentRegistration) []uuid.UUID {
+	registered := make(map[uuid.UUID]bool, len(registrations))
+	for _, reg := range registrations {
+		registered[reg.TeamID] = true
+	}
+
+	teams := make([]uuid.UUID, 0, len(registrations))
+	seeded := make(map[uuid.UUID]bool)
+	for _, seed := range tournament.StringListRule(domain.RuleSeeds, nil) {
+		id, err := uuid.Parse(seed)
+		if err != nil || !registered[id] || seeded[id] {
+			continue
+		}
+		teams = append(teams, id)
+		seeded[id] = true
+	}
+
+	for _, reg := range registrations {
+		if !seeded[reg.TeamID] {
+			teams = append(teams, reg.TeamID)
+		}
+	}
+
+	return teams
+}
+
+// fitGroups limits the configured number of groups so that every group has
+// at least two teams
+func fitGroups(groups, teams int) int {
+	if groups > teams/2 {
+		groups = teams / 2
+	}
+	if groups < 1 {
+		groups = 1
+	}
+	return groups
+}
+
+// groupRounds splits the seeded teams into groups by snake order and plays a
+// round robin in each. Rounds of different groups share the same round number.
+func groupRounds(tournamentID uuid.UUID, teams []uuid.UUID, groupCount int, double bool) [][]pairing {
+	groupCount = fitGroups(groupCount, len(teams))
+
+	groups := make([][]uuid.UUID, groupCount)
+	for i, team := range teams {
+		g := i % groupCount
+		if (i/groupCount)%2 == 1 {
+			g = groupCount - 1 - g
+		}
+		groups[g] = append(groups[g], team)
+	}
+
+	var rounds [][]pairing
+	for g, groupTeams := range groups {
+		var groupName *string
+		if groupCount > 1 {
+			groupName = strPtr(fmt.Sprintf("Group %c", 'A'+g))
+		}
+
+		for r, matches := range roundRobin(groupTeams, double) {
+			if r >= len(rounds) {
+				rounds = append(rounds, nil)
+			}
+
+			roundName := fmt.Sprintf("Round %d", r+1)
+			if groupName != nil {
+				roundName = fmt.Sprintf("%s Round %d", *groupName, r+1)
+			}
+
+			for _, m := range matches {
+				teamA, teamB := m[0], m[1]
+				rounds[r] = append(rounds[r], pairing{
+					teamA: &teamA,
+					teamB: &teamB,
+					link: domain.TournamentMatch{
+						TournamentID: tournamentID,
+						RoundNumber:  r + 1,
+						RoundName:    strPtr(roundName),
+						GroupName:    groupName,
+					},
+				})
+			}
+		}
+	}
+
+	return rounds
+}
+
+// roundRobin pairs every team with every other using the circle method: the
+// first team stays fixed while the rest rotate one place each round. An odd
+// field gets a bye slot. A double round robin repeats the rounds with home and
+// away swapped.
+func roundRobin(teams []uuid.UUID, double bool) [][][2]uuid.UUID {
+	circle := append([]uuid.UUID(nil), teams...)
+	if len(circle)%2 == 1 {
+		circle = append(circle, uuid.Nil)
+	}
+	n := len(circle)
+
+	var rounds [][][2]uuid.UUID
+	for r := 0; r < n-1; r++ {
+		var matches [][2]uuid.UUID
+		for i := 0; i < n/2; i++ {
+			home, away := circle[i], circle[n-1-i]
+			if home == uuid.Nil || away == uuid.Nil {
+				continue
+			}
+			// Alternate the fixed team so it is not always listed first
+			if i == 0 && r%2 == 1 {
+				home, away = away, home
+			}
+			matches = append(matches, [2]uuid.UUID{home, away})
+		}
+		rounds = append(rounds, matches)
+
+		last := circle[n-1]
+		copy(circle[2:], circle[1:n-1])
+		circle[1] = last
+	}
+
+	if double {
+		firstLeg := len(rounds)
+		for r := 0; r < firstLeg; r++ {
+			var matches [][2]uuid.UUID
+			for _, m := range rounds[r] {
+				matches = append(matches, [2]uuid.UUID{m[1], m[0]})
+			}
+			rounds = append(rounds, matches)
+		}
+	}
+
+	return rounds
+}
+
+// knockoutRounds builds a seeded single-elimination bracket. The field is
+// padded to a power of two with byes, which go to the top seeds; a team with a
+// bye is placed straight into its second round match. Every match links to the
+// match its winner advances to through NextMatchID.
+func knockoutRounds(tournamentID uuid.UUID, teams []uuid.UUID) [][]pairing {
+	size := 2
+	for size < len(teams) {
+		size *= 2
+	}
+	order := bracketOrder(size)
+	rounds := bracketRounds(tournamentID, size, 1)
+
+	// Fill the first round from the seeding order, promoting teams with byes
+	var firstRound []pairing
+	for i := range rounds[0] {
+		seedA, seedB := order[2*i], order[2*i+1]
+		var teamA, teamB *uuid.UUID
+		if seedA <= len(teams) {
+			teamA = &teams[seedA-1]
+		}
+		if seedB <= len(teams) {
+			teamB = &teams[seedB-1]
+		}
+
+		if teamA != nil && teamB != nil {
+			rounds[0][i].teamA, rounds[0][i].teamB = teamA, teamB
+			firstRound = append(firstRound, rounds[0][i])
+			continue
+		}
+
+		// Bye: the seeded team goes straight into the next round
+		advancing := teamA
+		if advancing == nil {
+			advancing = teamB
+		}
+		next := &rounds[1][i/2]
+		if i%2 == 0 {
+			next.teamA = advancing
+		} else {
+			next.teamB = advancing
+		}
+		// The bye slot has no match to advance from
+		rounds[0][i].link.NextMatchID = nil
+	}
+	rounds[0] = firstRound
+
+	return rounds
+}
+
+// qualifierRounds builds the knockout stage of a mixed tournament. Its first
+// round is left empty and is filled from the group standings once the group
+// stage is over, see seedQualifiers.
+func qualifierRounds(tournamentID uuid.UUID, groups, qualifiers, teams, firstRound int) ([][]pairing, error) {
+	if qualifiers < 1 || qualifiers > teams/groups {
+		return nil, fmt.Errorf("rule %s must be between 1 and %d for %d groups", domain.RuleQualifiersPerGroup, teams/groups, groups)
+	}
+
+	size := groups * qualifiers
+	if size < 2 || size&(size-1) != 0 {
+		return nil, fmt.Errorf("%d groups with %d qualifiers each must give a knockout of 2, 4, 8 or more teams in powers of two", groups, qualifiers)
+	}
+
+	return bracketRounds(tournamentID, size, firstRound), nil
+}
+
+// bracketRounds creates the empty rounds of a single-elimination bracket for
+// size teams, numbered from firstRound. Every match links to the match its
+// winner advances to through NextMatchID.
+func bracketRounds(tournamentID uuid.UUID, size, firstRound int) [][]pairing {
+	var rounds [][]pairing
+	for matches := size / 2; matches >= 1; matches /= 2 {
+		round := make([]pairing, matches)
+		roundName := knockoutRoundName(matches)
+		for i := range round {
+			round[i].link = domain.TournamentMatch{
+				ID:              uuid.New(),
+				TournamentID:    tournamentID,
+				RoundNumber:     firstRound + len(rounds),
+				RoundName:       strPtr(roundName),
+				BracketPosition: intPtr(i + 1),
+			}
+		}
+		rounds = append(rounds, round)
+	}
+
+	for r := 0; r < len(rounds)-1; r++ {
+		for i := range rounds[r] {
+			next := rounds[r+1][i/2].link.ID
+			rounds[r][i].link.NextMatchID = &next
+		}
+	}
+
+	return rounds
+}
+
+// qualifier is a finishing place in a group, e.g. Group A, 1st
+type qualifier struct {
+	group string
+	place int
+}
+
+// qualifierSeeds ranks the qualifiers of each group as knockout seeds: every
+// group winner, then every runner-up, and so on. Placed by bracketOrder this
+// keeps teams of the same group apart in the first knockout round.
+func qualifierSeeds(groups []string, qualifiers int) []qualifier {
+	seeds := make([]qualifier, 0, len(groups)*qualifiers)
+	for place := 1; place <= qualifiers; place++ {
+		for _, group := range groups {
+			seeds = append(seeds, qualifier{group: group, place: place})
+		}
+	}
+	return seeds
+}
+
+// bracketOrder returns the seed numbers in bracket slot order so that the top
+// seeds can only meet in the latest rounds, e.g. 1, 8, 4, 5, 2, 7, 3, 6 for 8
+func bracketOrder(size int) []int {
+	order := []int{1, 2}
+	for len(order) < size {
+		n := len(order) * 2
+		next := make([]int, 0, n)
+		for _, seed := range order {
+			next = append(next, seed, n+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+func knockoutRoundName(matches int) string {
+	switch matches {
+	case 1:
+		return "Final"
+	case 2:
+		return "Semi Final"
+	case 4:
+		return "Quarter Final"
+	}
+	return fmt.Sprintf("Round of %d", matches*2)
+}
+
+// scheduleFixtures assigns dates, times and match settings to the generated
+// rounds. Each round starts on a new day and fills the configured match times
+// of as many days as it needs.
+func scheduleFixtures(tournament *domain.Tournament, rounds [][]pairing, names map[uuid.UUID]string) ([]domain.Fixture, error) {
+	matchTimes := tournament.StringListRule(domain.RuleMatchTimes, []string{"10:00"})
+	if len(matchTimes) == 0 {
+		matchTimes = []string{"10:00"}
+	}
+
+	overs := tournament.IntRule(domain.RuleOversPerInnings, defaultOvers[tournament.MatchFormat])
+	ballType := "white"
+	if tournament.MatchFormat == "Test" {
+		ballType = "red"
+	}
+
+	venueName, venueCity := "TBD", "TBD"
+	if tournament.VenueName != nil {
+		venueName = *tournament.VenueName
+	}
+	if tournament.VenueCity != nil {
+		venueCity = *tournament.VenueCity
+	}
+
+	teamName := func(id *uuid.UUID) string {
+		if id == nil {
+			return "TBD"
+		}
+		if name, ok := names[*id]; ok {
+			return name
+		}
+		return "TBD"
+	}
+
+	start := time.Date(tournament.StartDate.Year(), tournament.StartDate.Month(), tournament.StartDate.Day(), 0, 0, 0, 0, tournament.StartDate.Location())
+	day := start
+	matchNumber := 0
+
+	var fixtures []domain.Fixture
+	for r, round := range rounds {
+		if r > 0 {
+			day = day.AddDate(0, 0, 1)
+		}
+		for i, p := range round {
+			if i > 0 && i%len(matchTimes) == 0 {
+				day = day.AddDate(0, 0, 1)
+			}
+			if day.After(tournament.EndDate) {
+				return nil, fmt.Errorf("fixtures do not fit between the start and end date, add more match times or extend the tournament")
+			}
+
+			matchNumber++
+			link := p.link
+			if link.ID == uuid.Nil {
+				link.ID = uuid.New()
+			}
+			link.MatchID = uuid.New()
+			link.MatchNumber = intPtr(matchNumber)
+			link.CreatedAt = time.Now()
+
+			fixtures = append(fixtures, domain.Fixture{
+				Link:        link,
+				Title:       fmt.Sprintf("%s: %s vs %s", *link.RoundName, teamName(p.teamA), teamName(p.teamB)),
+				TeamAID:     p.teamA,
+				TeamBID:     p.teamB,
+				MatchDate:   day,
+				MatchTime:   matchTimes[i%len(matchTimes)],
+				MatchFormat: tournament.MatchFormat,
+				TotalOvers:  overs,
+				BallType:    ballType,
+				VenueName:   venueName,
+				VenueCity:   venueCity,
+				GroundID:    tournament.GroundID,
+				CreatedBy:   tournament.OrganizerID,
+			})
+		}
+	}
+
+	return fixtures, nil
+}
+
+func intPtr(i int) *int {
+	return &i
+}
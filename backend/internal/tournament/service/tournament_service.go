@@ -46,6 +46,10 @@ func (s *tournamentService) CreateTournament(ctx context.Context, req domain.Cre
 		return nil, fmt.Errorf("invalid match format: %s", req.MatchFormat)
 	}
 
+	if err := domain.ValidateRules(req.Rules); err != nil {
+		return nil, err
+	}
+
 	tournament := &domain.Tournament{
 		ID:                   uuid.New(),
 		Name:                 req.Name,
@@ -147,6 +151,9 @@ func (s *tournamentService) UpdateTournament(ctx context.Context, tournamentID u
 		tournament.PrizePool = *req.PrizePool
 	}
 	if req.Rules != nil {
+		if err := domain.ValidateRules(req.Rules); err != nil {
+			return nil, err
+		}
 		tournament.Rules = req.Rules
 	}
 	if req.VenueName != nil {
@@ -244,6 +251,10 @@ func (s *tournamentService) StartTournament(ctx context.Context, tournamentID uu
 		return fmt.Errorf("can only start tournaments with closed registration")
 	}
 
+	if err := s.generateFixtures(ctx, tournament); err != nil {
+		return err
+	}
+
 	return s.repo.UpdateTournamentStatus(ctx, tournamentID, "ongoing")
 }
 
@@ -289,6 +300,10 @@ func (s *tournamentService) RegisterTeam(ctx context.Context, tournamentID uuid.
 		return nil, err
 	}
 
+	if err := s.requireTeamManager(ctx, req.TeamID, userID); err != nil {
+		return nil, err
+	}
+
 	// Check if registration is open
 	if tournament.Status != "registration_open" {
 		return nil, fmt.Errorf("registration is not open for this tournament")
@@ -361,16 +376,62 @@ func (s *tournamentService) ListRegistrations(ctx context.Context, tournamentID
 }
 
 func (s *tournamentService) ApproveRegistration(ctx context.Context, registrationID uuid.UUID, userID uuid.UUID) error {
-	// Get registration to find tournament
-	// For now, we'll update directly - in production, we'd verify organizer access
+	if _, err := s.pendingRegistration(ctx, registrationID, userID); err != nil {
+		return err
+	}
+
 	return s.repo.UpdateRegistrationStatus(ctx, registrationID, "approved", userID, nil)
 }
 
 func (s *tournamentService) RejectRegistration(ctx context.Context, registrationID uuid.UUID, reason string, userID uuid.UUID) error {
+	if _, err := s.pendingRegistration(ctx, registrationID, userID); err != nil {
+		return err
+	}
+
 	return s.repo.UpdateRegistrationStatus(ctx, registrationID, "rejected", userID, &reason)
 }
 
+// pendingRegistration loads a registration awaiting a decision and checks
+// that userID organizes its tournament
+func (s *tournamentService) pendingRegistration(ctx context.Context, registrationID, userID uuid.UUID) (*domain.TournamentRegistration, error) {
+	registration, err := s.repo.GetRegistrationByID(ctx, registrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	tournament, err := s.repo.GetTournamentByID(ctx, registration.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if tournament.OrganizerID != userID {
+		return nil, fmt.Errorf("only the organizer can review registrations")
+	}
+
+	if registration.Status != "pending" {
+		return nil, fmt.Errorf("registration is already %s", registration.Status)
+	}
+
+	return registration, nil
+}
+
+// requireTeamManager checks that userID created or captains the team
+func (s *tournamentService) requireTeamManager(ctx context.Context, teamID, userID uuid.UUID) error {
+	manager, err := s.repo.IsTeamManager(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if !manager {
+		return fmt.Errorf("only the team's manager can register or withdraw it")
+	}
+
+	return nil
+}
+
 func (s *tournamentService) WithdrawRegistration(ctx context.Context, tournamentID, teamID uuid.UUID, userID uuid.UUID) error {
+	if err := s.requireTeamManager(ctx, teamID, userID); err != nil {
+		return err
+	}
+
 	registration, err := s.repo.GetRegistration(ctx, tournamentID, teamID)
 	if err != nil {
 		return err
@@ -401,6 +462,30 @@ func (s *tournamentService) RefreshStandings(ctx context.Context, tournamentID u
 	return s.repo.RecalculateStandings(ctx, tournamentID)
 }
 
+// HandleMatchCompleted refreshes the standings of the tournament a completed
+// match belongs to. A knockout match advances its winner to the next round and
+// the last group match of a mixed tournament seeds the knockout stage. Matches
+// outside any tournament are ignored.
+func (s *tournamentService) HandleMatchCompleted(ctx context.Context, matchID uuid.UUID) error {
+	tournamentMatch, err := s.repo.GetTournamentMatchByMatchID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if tournamentMatch == nil {
+		return nil
+	}
+
+	if err := s.repo.RecalculateStandings(ctx, tournamentMatch.TournamentID); err != nil {
+		return err
+	}
+
+	if tournamentMatch.BracketPosition == nil {
+		return s.seedQualifiers(ctx, tournamentMatch.TournamentID)
+	}
+
+	return s.progressKnockout(ctx, tournamentMatch)
+}
+
 // Tournament match operations
 
 func (s *tournamentService) GetTournamentMatches(ctx context.Context, tournamentID uuid.UUID, roundNumber *int, groupName *string) (*domain.TournamentMatchesResponse, error) {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -13,6 +14,9 @@ import (
 func main() {
 	// Load configuration
 	cfg := config.Load()
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
 
 	// Connect to database
 	db, err := database.Connect(cfg.Database)
@@ -26,6 +30,11 @@ func main() {
 	// Create HTTP server
 	srv := server.New(cfg, db)
 
+	// Start background jobs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv.StartBackgroundJobs(ctx)
+
 	// Start server
 	addr := fmt.Sprintf(":%s", cfg.Server.Port)
 	log.Printf("🚀 Server starting on http://localhost%s", addr)